@@ -36,8 +36,7 @@ func NewGenerator(cfg *config.Config) *Generator {
 }
 
 func (g *Generator) existsFile(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
+	return g.cfg.OutputWriter().Exists(path)
 }
 
 func (g *Generator) readAPI(path string) ([]*types.API, error) {
@@ -97,11 +96,11 @@ func (g *Generator) writeAPIIndex(api []*types.API) error {
 	if err := indexTmpl.Execute(&buf, api); err != nil {
 		return xerrors.Errorf("failed to execute template: %w", err)
 	}
-	if err := os.MkdirAll("docs", 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll("docs"); err != nil {
 		return xerrors.Errorf("cannot create directory to docs: %w", err)
 	}
 	path := filepath.Join("docs", "index.md")
-	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(path, buf.Bytes(), 0644); err != nil {
 		return xerrors.Errorf("cannot write file %s: %w", path, err)
 	}
 	return nil
@@ -121,7 +120,7 @@ func (g *Generator) generateDocument(api []*types.API, classMap *map[string]*typ
 			return xerrors.Errorf("failed to execute template: %w", err)
 		}
 		path := filepath.Join("docs", fmt.Sprintf("%s.md", subAPI.Name.SnakeName()))
-		if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		if err := g.cfg.OutputWriter().WriteFile(path, buf.Bytes(), 0644); err != nil {
 			return xerrors.Errorf("cannot write file %s: %w", path, err)
 		}
 	}
@@ -315,11 +314,11 @@ func (g *Generator) generateRequest(path string, api []*types.API, classMap *map
 		source := []byte(fmt.Sprintf("%#v", f))
 		apiPath := filepath.Join(path, fmt.Sprintf("%s.go", subAPI.Name.SnakeName()))
 		if g.existsFile(apiPath) {
-			if err := os.Remove(apiPath); err != nil {
+			if err := g.cfg.OutputWriter().Remove(apiPath); err != nil {
 				return xerrors.Errorf("failed to remove file %s: %w", apiPath, err)
 			}
 		}
-		if err := ioutil.WriteFile(apiPath, source, 0444); err != nil {
+		if err := g.cfg.OutputWriter().WriteFile(apiPath, source, 0444); err != nil {
 			return xerrors.Errorf("cannot write file %s: %w", apiPath, err)
 		}
 	}
@@ -470,11 +469,11 @@ func (g *Generator) generateResponse(path string, api []*types.API, classMap *ma
 		source := []byte(fmt.Sprintf("%#v", f))
 		apiPath := filepath.Join(path, fmt.Sprintf("%s.go", subAPI.Name.SnakeName()))
 		if g.existsFile(apiPath) {
-			if err := os.Remove(apiPath); err != nil {
+			if err := g.cfg.OutputWriter().Remove(apiPath); err != nil {
 				return xerrors.Errorf("failed to remove file %s: %w", apiPath, err)
 			}
 		}
-		if err := ioutil.WriteFile(apiPath, source, 0444); err != nil {
+		if err := g.cfg.OutputWriter().WriteFile(apiPath, source, 0444); err != nil {
 			return xerrors.Errorf("cannot write file %s: %w", apiPath, err)
 		}
 	}
@@ -505,10 +504,10 @@ func (g *Generator) Generate(classes []*types.Class) error {
 	}
 	requestPkgName := g.cfg.RequestPackageName()
 	responsePkgName := g.cfg.ResponsePackageName()
-	if err := os.MkdirAll(requestPkgName, 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(requestPkgName); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", requestPkgName, err)
 	}
-	if err := os.MkdirAll(responsePkgName, 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(responsePkgName); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", responsePkgName, err)
 	}
 	classMap := map[string]*types.Class{}
@@ -0,0 +1,149 @@
+package eevee
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/goccy/go-yaml"
+	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/output"
+	"go.knocknote.io/eevee/types"
+	"golang.org/x/xerrors"
+)
+
+// CacheFilePath path of cache to skip generating files for unchanged classes.
+// it can be removed at any time to generate all files again.
+const CacheFilePath = ".eevee.cache"
+
+// generateCache keeps hash of inputs and generated files for each class
+type generateCache struct {
+	Classes map[string]*classCache `json:"classes"`
+}
+
+type classCache struct {
+	Hash  string   `json:"hash"`
+	Files []string `json:"files"`
+}
+
+// readCache reads cache from path. broken cache is treated as empty, because it is only used to skip generation.
+func readCache(writer output.Writer, path string) *generateCache {
+	cache := &generateCache{Classes: map[string]*classCache{}}
+	content, err := writer.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	var readCache generateCache
+	if err := json.Unmarshal(content, &readCache); err != nil || readCache.Classes == nil {
+		return cache
+	}
+	return &readCache
+}
+
+func (c *generateCache) write(writer output.Writer, path string) error {
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return xerrors.Errorf("cannot marshal cache: %w", err)
+	}
+	if err := writer.WriteFile(path, content, 0644); err != nil {
+		return xerrors.Errorf("cannot write cache to %s: %w", path, err)
+	}
+	return nil
+}
+
+// isValid returns true if class is generated by the same inputs as hash and generated files are not changed since then
+func (c *generateCache) isValid(writer output.Writer, manifest *output.Manifest, class *types.Class, hash string) bool {
+	cache, exists := c.Classes[class.Name.SnakeName()]
+	if !exists || cache.Hash != hash {
+		return false
+	}
+	for _, path := range cache.Files {
+		if !manifest.Contains(path) {
+			return false
+		}
+		content, err := writer.ReadFile(path)
+		if err != nil {
+			return false
+		}
+		if output.Hash(content) != manifest.Hash(path) {
+			return false
+		}
+	}
+	return true
+}
+
+// executableHash returns hash of running eevee.
+// eevee version and installed plugins are compiled into executable, so hash is changed by them.
+func executableHash() (string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", xerrors.Errorf("cannot get path of executable: %w", err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", xerrors.Errorf("cannot open executable %s: %w", path, err)
+	}
+	defer file.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", xerrors.Errorf("cannot read executable %s: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// dependentClasses returns classes referred by members of class in order of name.
+// generated files of class depend on them ( e.g. relation ), so they are contained in inputs of class.
+func dependentClasses(class *types.Class) []*types.Class {
+	classMap := map[string]*types.Class{}
+	for _, member := range class.Members {
+		if member.Type == nil {
+			continue
+		}
+		subClass := member.Type.Class()
+		if subClass == nil || subClass == class {
+			continue
+		}
+		classMap[subClass.Name.SnakeName()] = subClass
+	}
+	names := make([]string, 0, len(classMap))
+	for name := range classMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	classes := make([]*types.Class, 0, len(names))
+	for _, name := range names {
+		classes = append(classes, classMap[name])
+	}
+	return classes
+}
+
+// classInputHashes returns hash of inputs for each class.
+// inputs are running eevee, config, class and classes referred by class.
+func classInputHashes(cfg *config.Config, classes []*types.Class) (map[*types.Class]string, error) {
+	baseHash, err := executableHash()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get hash of executable: %w", err)
+	}
+	cfgContent, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot marshal config: %w", err)
+	}
+	hashes := map[*types.Class]string{}
+	for _, class := range classes {
+		h := sha256.New()
+		h.Write([]byte(baseHash))
+		h.Write(cfgContent)
+		for _, inputClass := range append([]*types.Class{class}, dependentClasses(class)...) {
+			content, err := yaml.Marshal(inputClass)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot marshal class %s: %w", inputClass.Name.SnakeName(), err)
+			}
+			h.Write(content)
+		}
+		hashes[class] = hex.EncodeToString(h.Sum(nil))
+	}
+	return hashes, nil
+}
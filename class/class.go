@@ -43,6 +43,9 @@ func (r *Reader) ReadClass(cfg *config.Config, path string) (*types.Class, error
 	if class.DataStore == "" {
 		class.DataStore = cfg.DataStore()
 	}
+	if cfg.SchemaPath == "" && !cfg.ReadsSchemaFromDatabase() {
+		r.assumeAutoIncrementID(&class)
+	}
 	if cfg.Renderer != nil {
 		for _, member := range class.Members {
 			if member.Render != nil {
@@ -69,6 +72,21 @@ func (r *Reader) ReadClass(cfg *config.Config, path string) (*types.Class, error
 	return &class, nil
 }
 
+// assumeAutoIncrementID treats integer id member as auto_increment like previous versions
+// when class file is written without schema and no member declares auto_increment.
+func (r *Reader) assumeAutoIncrementID(class *types.Class) {
+	if class.AutoIncrementMember() != nil {
+		return
+	}
+	member := class.MemberByName("id")
+	if member == nil || member.Type == nil || member.Extend || member.Relation != nil {
+		return
+	}
+	if member.Type.Type.IsInt() || member.Type.Type.IsUint() {
+		member.AutoIncrement = true
+	}
+}
+
 func (r *Reader) ClassByConfig(cfg *config.Config) ([]*types.Class, error) {
 	path := cfg.ClassPath
 	if filepath.Ext(path) == "yml" {
@@ -104,6 +122,7 @@ func (r *Reader) ClassByConfig(cfg *config.Config) ([]*types.Class, error) {
 			return nil, xerrors.Errorf("cannot resolve type reference: %w", err)
 		}
 	}
+	types.ResolveEnumTypes(classes)
 	return classes, nil
 }
 
@@ -118,9 +137,6 @@ type Writer struct {
 }
 
 func NewWriter(path string) (*Writer, error) {
-	if err := os.MkdirAll(path, 0755); err != nil {
-		return nil, xerrors.Errorf("cannot mkdir for %s: %w", path, err)
-	}
 	return &Writer{
 		path:   path,
 		reader: NewReader(),
@@ -128,6 +144,9 @@ func NewWriter(path string) (*Writer, error) {
 }
 
 func (w *Writer) Write(cfg *config.Config, schema *types.Class) error {
+	if err := cfg.OutputWriter().MkdirAll(w.path); err != nil {
+		return xerrors.Errorf("cannot mkdir for %s: %w", w.path, err)
+	}
 	path := filepath.Join(w.path, fmt.Sprintf("%s.yml", schema.Name.SnakeName()))
 	class := schema
 	if w.reader.existsFile(path) {
@@ -142,7 +161,7 @@ func (w *Writer) Write(cfg *config.Config, schema *types.Class) error {
 	if err != nil {
 		return xerrors.Errorf("cannot marshal class: %w", err)
 	}
-	if err := ioutil.WriteFile(path, source, 0644); err != nil {
+	if err := cfg.OutputWriter().WriteFile(path, source, 0644); err != nil {
 		return xerrors.Errorf("cannot write file to %s: %w", path, err)
 	}
 	return nil
@@ -1,7 +1,10 @@
 package class_test
 
 import (
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"go.knocknote.io/eevee/class"
@@ -97,4 +100,211 @@ func TestClassRead(t *testing.T) {
 	if worldMember.Render.IsRender {
 		t.Fatal("cannot setup skill skill.Render.IsRender")
 	}
+	t.Run("enum", func(t *testing.T) {
+		member := userClass.MemberByName("sex")
+		if member == nil {
+			t.Fatal("cannot setup sex member")
+		}
+		if !member.IsEnumType() || member.Type.Name() != "Sex" || !member.Type.Type.IsString() {
+			t.Fatalf("cannot resolve enum type: %+v", member.Type.Type)
+		}
+		constants := member.EnumConstants()
+		if len(constants) != 2 || constants[0].Name != "SexMan" || constants[1].Name != "SexWoman" {
+			t.Fatal("cannot get enum constants")
+		}
+	})
+}
+
+func TestClassWriteRelation(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	// relation to user is already edited by user as 'owner'
+	definedClass := []byte(`name: user_profile
+members:
+- name: id
+  type: uint64
+- name: user_id
+  type: uint64
+- name: owner
+  extend: true
+  relation:
+    to: user
+    internal: user_id
+    external: id
+  render: false
+`)
+	if err := ioutil.WriteFile(filepath.Join(classPath, "user_profile.yml"), definedClass, 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	schemata, err := schema.NewReader().SchemaFromPath(filepath.Join("..", "schema", "testdata", "foreign_key"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	writer, err := class.NewWriter(classPath)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cfg := &config.Config{ClassPath: classPath}
+	for _, class := range schema.ToClasses(schemata) {
+		if err := writer.Write(cfg, class); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	classMap := map[string]*types.Class{}
+	for _, class := range classes {
+		classMap[class.Name.SnakeName()] = class
+	}
+	profile := classMap["user_profile"]
+	if profile.MemberByName("user") != nil {
+		t.Fatal("inferred relation is duplicated with edited relation")
+	}
+	owner := profile.MemberByName("owner")
+	if owner == nil || owner.Render.IsRender {
+		t.Fatal("edited relation is overwritten")
+	}
+	if len(profile.Members) != 4 || profile.MemberByName("bio") == nil {
+		t.Fatal("failed to merge members")
+	}
+	messages := classMap["user"].MemberByName("messages")
+	if messages == nil || !messages.HasMany {
+		t.Fatal("failed to write inverse relation")
+	}
+	if messages.Type.Class() == nil {
+		t.Fatal("cannot resolve class reference of inferred relation")
+	}
+}
+
+func TestClassWriteRange(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	// range finder is declared by user for key of fields
+	definedClass := []byte(`name: field
+index:
+  primary_key: id
+  keys:
+  - - object_num
+  - columns:
+    - difficulty
+    - level
+    range:
+    - between
+    - greater_than
+members:
+- name: id
+  type: uint64
+`)
+	if err := ioutil.WriteFile(filepath.Join(classPath, "field.yml"), definedClass, 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	schemata, err := schema.NewReader().SchemaFromPath(filepath.Join("testdata", "schema"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	writer, err := class.NewWriter(classPath)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cfg := &config.Config{ClassPath: classPath}
+	for _, schema := range schemata {
+		if schema.Name != "field" {
+			continue
+		}
+		if err := writer.Write(cfg, schema.ToClass()); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(classes) != 1 {
+		t.Fatalf("failed to read class: %d", len(classes))
+	}
+	rangeKeys := classes[0].RangeKeys()
+	if len(rangeKeys) != 2 {
+		t.Fatalf("range declared in class file is not kept: %d", len(rangeKeys))
+	}
+	if rangeKeys[0].Operator != types.RangeBetween || rangeKeys[1].Operator != types.RangeGreaterThan {
+		t.Fatalf("unexpected range operators: %s %s", rangeKeys[0].Operator, rangeKeys[1].Operator)
+	}
+	if names := rangeKeys[0].Members.JoinedName(); names != "difficulty:level" {
+		t.Fatalf("unexpected members of range key: %s", names)
+	}
+	source, err := ioutil.ReadFile(filepath.Join(classPath, "field.yml"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !strings.Contains(string(source), "  - - object_num\n") {
+		t.Fatalf("key without range should be written as list of columns:\n%s", string(source))
+	}
+}
+
+func TestClassReadAutoIncrement(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	if err := ioutil.WriteFile(filepath.Join(classPath, "user.yml"), []byte(`
+name: user
+index:
+  primary_key: id
+members:
+- name: id
+  type: uint64
+- name: name
+  type: string
+`), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(classPath, "token.yml"), []byte(`
+name: token
+index:
+  primary_key: id
+members:
+- name: id
+  type: string
+- name: seq
+  type: int64
+  auto_increment: true
+`), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, test := range []struct {
+		cfg      *config.Config
+		expected map[string]string
+	}{
+		{
+			cfg:      &config.Config{ClassPath: classPath},
+			expected: map[string]string{"user": "id", "token": "seq"},
+		},
+		{
+			cfg:      &config.Config{ClassPath: classPath, SchemaPath: "schema"},
+			expected: map[string]string{"user": "", "token": "seq"},
+		},
+	} {
+		classes, err := class.NewReader().ClassByConfig(test.cfg)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, class := range classes {
+			name := ""
+			if member := class.AutoIncrementMember(); member != nil {
+				name = member.Name.SnakeName()
+			}
+			if expected := test.expected[class.Name.SnakeName()]; name != expected {
+				t.Fatalf("unexpected auto_increment member of %s: %q", class.Name, name)
+			}
+		}
+	}
 }
@@ -7,30 +7,39 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 
+	_ "github.com/go-sql-driver/mysql"
 	"github.com/jessevdk/go-flags"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"go.knocknote.io/eevee"
 	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/types"
 	"go.knocknote.io/eevee/watcher"
 	"golang.org/x/xerrors"
 )
 
 type Option struct {
-	Init   InitCommand   `description:"create .eevee.yml for configuration"                              command:"init"`
-	Run    RunCommand    `description:"generate files by referring to .eevee.yml"                        command:"run"`
-	Plugin PluginCommand `description:"manage plugin"                                                    command:"plugin"`
-	Watch  WatchCommand  `description:"watching changed files and regenerates (implemented by fsnotify)" command:"watch"`
-	Serve  ServeCommand  `description:"serve dependency graph files"                                     command:"serve"`
+	Init    InitCommand    `description:"create .eevee.yml for configuration"                              command:"init"`
+	Run     RunCommand     `description:"generate files by referring to .eevee.yml"                        command:"run"`
+	Plugin  PluginCommand  `description:"manage plugin"                                                    command:"plugin"`
+	Watch   WatchCommand   `description:"watching changed files and regenerates (implemented by fsnotify)" command:"watch"`
+	Serve   ServeCommand   `description:"serve dependency graph files"                                     command:"serve"`
+	Migrate MigrateCommand `description:"generate migration files from the difference of tables"          command:"migrate"`
+	Check   CheckCommand   `description:"verify generated files are up to date without writing"            command:"check"`
 }
 
 type InitCommand struct {
-	SchemaPath string `description:"schema file(or directory) path. try read 'sql' suffix file"          long:"schema" short:"s"`
-	ClassPath  string `description:"generated class file(or directory) path. try read 'yml' suffix file" long:"class"  short:"c"`
-	APIPath    string `description:"api definition file path. try read 'yml' suffix file"                long:"api"    short:"a"`
-	GraphPath  string `description:"visualize relationships between tables"                              long:"graph"  short:"g"`
-	OutputPath string `description:"specify an output directory of source code"                          long:"output" short:"o"`
+	SchemaPath string `description:"schema file(or directory) path. try read 'sql' suffix file"          long:"schema"  short:"s"`
+	SchemaDSN  string `description:"data source name of database to read schema instead of files"        long:"dsn"`
+	Dialect    string `description:"SQL dialect of schema and generated code (mysql/postgres/sqlite)"    long:"dialect" short:"d"`
+	ClassPath  string `description:"generated class file(or directory) path. try read 'yml' suffix file" long:"class"   short:"c"`
+	APIPath    string `description:"api definition file path. try read 'yml' suffix file"                long:"api"     short:"a"`
+	GraphPath  string `description:"visualize relationships between tables"                              long:"graph"   short:"g"`
+	OutputPath string `description:"specify an output directory of source code"                          long:"output"  short:"o"`
 }
 
 type RunCommand struct {
@@ -100,6 +109,14 @@ type WatchCommand struct {
 	ConfigPath string `description:"config path" long:"config" short:"c"`
 }
 
+type MigrateCommand struct {
+	Name       string `description:"name of migration file"               long:"name"   short:"n"`
+	FromSchema bool   `description:"compare schema instead of class files" long:"schema" short:"s"`
+}
+
+type CheckCommand struct {
+}
+
 type ServeCommand struct {
 	ConfigPath string `description:"config path"        long:"config" short:"c"`
 	Port       int    `description:"listen port number" long:"port"   short:"p"`
@@ -113,14 +130,20 @@ func (cmd *InitCommand) Execute(args []string) error {
 	if err != nil {
 		return xerrors.Errorf("failed to read module name: %w", err)
 	}
-	if err := config.WriteConfig(&config.Config{
+	cfg := &config.Config{
 		ModulePath: modPath,
-		SchemaPath: cmd.SchemaPath,
+		Dialect:    types.Dialect(cmd.Dialect),
 		ClassPath:  cmd.ClassPath,
 		APIPath:    cmd.APIPath,
 		GraphPath:  cmd.GraphPath,
 		OutputPath: cmd.OutputPath,
-	}); err != nil {
+	}
+	if cmd.SchemaDSN != "" {
+		cfg.SchemaDSN = cmd.SchemaDSN
+	} else {
+		cfg.SchemaPath = cmd.SchemaPath
+	}
+	if err := config.WriteConfig(cfg); err != nil {
 		return xerrors.Errorf("failed to write config: %w", err)
 	}
 	return nil
@@ -160,6 +183,53 @@ func (cmd *WatchCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd *MigrateCommand) Execute(args []string) error {
+	if !config.ExistsConfig() {
+		return xerrors.Errorf("`eevee init` must be executed before `eevee migrate`")
+	}
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return xerrors.Errorf("failed to read %s: %w", config.ConfigFilePath, err)
+	}
+	migration, err := eevee.Migrate(cfg, cmd.Name, cmd.FromSchema)
+	if err != nil {
+		return xerrors.Errorf("failed to migrate: %w", err)
+	}
+	if migration == nil {
+		log.Printf("no changes to migrate")
+		return nil
+	}
+	log.Printf("generated %s and %s", migration.UpFileName(), migration.DownFileName())
+	return nil
+}
+
+// errNotUpToDate is returned by check command to exit with status 1 if generated files are different from files on disk
+var errNotUpToDate = xerrors.New("run `eevee run` to regenerate them")
+
+func (cmd *CheckCommand) Execute(args []string) error {
+	if !config.ExistsConfig() {
+		return xerrors.Errorf("`eevee init` must be executed before `eevee check`")
+	}
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return xerrors.Errorf("failed to read %s: %w", config.ConfigFilePath, err)
+	}
+	if cfg.ModulePath == "" {
+		return xerrors.Errorf("'module' value must be declared")
+	}
+	diffs, err := eevee.Check(cfg)
+	if err != nil {
+		return xerrors.Errorf("failed to check: %w", err)
+	}
+	if len(diffs) == 0 {
+		return nil
+	}
+	for _, diff := range diffs {
+		fmt.Print(diff.Diff)
+	}
+	return xerrors.Errorf("%d files are not up to date: %w", len(diffs), errNotUpToDate)
+}
+
 func (cmd *ServeCommand) Execute(args []string) error {
 	if !config.ExistsConfig() {
 		return xerrors.Errorf("`eevee init` must be executed before `eevee run`")
@@ -190,5 +260,9 @@ var opts Option
 
 func main() {
 	parser := flags.NewParser(&opts, flags.Default)
-	parser.Parse()
+	if _, err := parser.Parse(); err != nil {
+		if xerrors.Is(err, errNotUpToDate) {
+			os.Exit(1)
+		}
+	}
 }
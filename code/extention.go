@@ -7,6 +7,10 @@ type CodeHelper interface {
 const GeneratedMarker = "Code generated by eevee. DO NOT EDIT!"
 const FuncGeneratedMarker = "generated by eevee"
 
+// MergedFileMarker is put before package clause of the file that code written by hand is merged into.
+// it follows the convention of generated file ( https://golang.org/s/generatedcode ), so tools like linters skip the file.
+const MergedFileMarker = "Code generated by eevee except declarations without \"" + FuncGeneratedMarker + "\" marker. DO NOT EDIT."
+
 func WrapError(h CodeHelper, msg string) *Statement {
 	return Qual(h.Package("xerrors"), "Errorf").Call(Lit(msg), Err())
 }
@@ -0,0 +1,339 @@
+package code
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+	"golang.org/x/xerrors"
+)
+
+// funcKey returns name of function with receiver type name ( e.g. User.ToJSON ) to distinguish methods of different types in the same file
+func funcKey(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return decl.Name.String()
+	}
+	typ := decl.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.String() + "." + decl.Name.String()
+	}
+	return decl.Name.String()
+}
+
+// hasGeneratedMarker returns true if the last line of doc comment is generated marker
+func hasGeneratedMarker(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	lines := strings.Split(strings.TrimRight(doc.Text(), "\n"), "\n")
+	return lines[len(lines)-1] == FuncGeneratedMarker
+}
+
+// isGeneratedFile returns true if whole file is generated ( it has generated marker before package clause )
+func isGeneratedFile(f *ast.File) bool {
+	for _, group := range f.Comments {
+		if group.Pos() > f.Package {
+			break
+		}
+		if strings.Contains(group.Text(), GeneratedMarker) {
+			return true
+		}
+	}
+	return false
+}
+
+// customizedFuncs returns functions that don't have generated marker in order of appearance
+func customizedFuncs(f *ast.File) []*ast.FuncDecl {
+	decls := []*ast.FuncDecl{}
+	if isGeneratedFile(f) {
+		return decls
+	}
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || hasGeneratedMarker(funcDecl.Doc) {
+			continue
+		}
+		decls = append(decls, funcDecl)
+	}
+	return decls
+}
+
+// genDeclNames returns names of types, variables and constants declared by decl
+func genDeclNames(decl *ast.GenDecl) []string {
+	names := []string{}
+	for _, spec := range decl.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			names = append(names, s.Name.String())
+		case *ast.ValueSpec:
+			for _, name := range s.Names {
+				names = append(names, name.String())
+			}
+		}
+	}
+	return names
+}
+
+// declaredNames returns names of types, variables and constants declared at top level of f
+func declaredNames(f *ast.File) map[string]struct{} {
+	names := map[string]struct{}{}
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, name := range genDeclNames(genDecl) {
+			names[name] = struct{}{}
+		}
+	}
+	return names
+}
+
+// customizedGenDecls returns types, variables and constants written by hand in order of appearance.
+// declaration that has generated marker was generated by previous time, so it is not contained even if it isn't generated this time.
+// declaration that has the same name as generated one is not contained too for the file generated before declarations had marker.
+func customizedGenDecls(f *ast.File, generatedNames map[string]struct{}) []*ast.GenDecl {
+	decls := []*ast.GenDecl{}
+	if isGeneratedFile(f) {
+		return decls
+	}
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok == token.IMPORT || hasGeneratedMarker(genDecl.Doc) {
+			continue
+		}
+		isGenerated := false
+		for _, name := range genDeclNames(genDecl) {
+			if _, exists := generatedNames[name]; exists {
+				isGenerated = true
+				break
+			}
+		}
+		if !isGenerated {
+			decls = append(decls, genDecl)
+		}
+	}
+	return decls
+}
+
+// commentTexts returns texts of all comments in f
+func commentTexts(f *ast.File) map[string]struct{} {
+	texts := map[string]struct{}{}
+	for _, group := range f.Comments {
+		texts[group.Text()] = struct{}{}
+	}
+	return texts
+}
+
+// customizedComments returns comments written by hand that are not attached to any declaration.
+// comments before package clause are returned as header.
+func customizedComments(fset *token.FileSet, f *ast.File, generatedTexts map[string]struct{}) ([]*ast.CommentGroup, []*ast.CommentGroup) {
+	header := []*ast.CommentGroup{}
+	comments := []*ast.CommentGroup{}
+	if isGeneratedFile(f) {
+		return header, comments
+	}
+	for _, group := range f.Comments {
+		if _, exists := generatedTexts[group.Text()]; exists {
+			continue
+		}
+		if group.End() < f.Package {
+			header = append(header, group)
+			continue
+		}
+		if group == f.Doc || group.Pos() < f.Name.End() {
+			continue
+		}
+		attached := false
+		for _, decl := range f.Decls {
+			start, end := declRange(fset, decl)
+			if offset := fset.Position(group.Pos()).Offset; start <= offset && offset < end {
+				attached = true
+				break
+			}
+		}
+		if !attached {
+			comments = append(comments, group)
+		}
+	}
+	return header, comments
+}
+
+// HasCustomizedCode returns true if src has functions, types, variables or constants written by hand
+func HasCustomizedCode(src []byte) bool {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ParseComments)
+	if err != nil {
+		return false
+	}
+	return len(customizedFuncs(f)) > 0 || len(customizedGenDecls(f, map[string]struct{}{})) > 0
+}
+
+// declRange returns range of declaration in source including its doc comment
+func declRange(fset *token.FileSet, decl ast.Decl) (int, int) {
+	start := decl.Pos()
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	}
+	return fset.Position(start).Offset, fset.Position(decl.End()).Offset
+}
+
+// FileReader reads file to merge customized code. output.Writer implements this.
+type FileReader interface {
+	Exists(path string) bool
+	ReadFile(path string) ([]byte, error)
+}
+
+// MergeCustomizedFile merges code written by hand in the file at path into generated source.
+// if the file doesn't exist, generated source is returned with generated marker.
+func MergeCustomizedFile(reader FileReader, path string, generated []byte) ([]byte, error) {
+	var current []byte
+	if reader.Exists(path) {
+		content, err := reader.ReadFile(path)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read file %s: %w", path, err)
+		}
+		current = content
+	}
+	merged, err := MergeCustomizedCode(generated, current)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot merge customized code in %s: %w", path, err)
+	}
+	return merged, nil
+}
+
+// MergeCustomizedCode adds generated marker to each function, type, variable and constant in generated source,
+// and merges code written by hand in current source into it.
+// merged source has MergedFileMarker before package clause.
+// code written by hand is functions without generated marker, types, variables and constants not declared in generated source,
+// and comments not attached to any declaration.
+// if function written by hand has the same name as generated function, generated one is replaced by it.
+// current source that has generated marker before package clause was generated as a whole, so its code is not merged.
+func MergeCustomizedCode(generated, current []byte) ([]byte, error) {
+	generated = append([]byte(fmt.Sprintf("// %s\n\n", MergedFileMarker)), generated...)
+	generatedFset := token.NewFileSet()
+	generatedFile, err := parser.ParseFile(generatedFset, "", generated, parser.ParseComments)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse generated source: %w", err)
+	}
+	customizedFuncDecls := []*ast.FuncDecl{}
+	customized := []ast.Node{}
+	header := []*ast.CommentGroup{}
+	currentFset := token.NewFileSet()
+	var currentFile *ast.File
+	if current != nil {
+		f, err := parser.ParseFile(currentFset, "", current, parser.ParseComments)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot parse current source: %w", err)
+		}
+		currentFile = f
+		customizedFuncDecls = customizedFuncs(f)
+		for _, decl := range customizedFuncDecls {
+			customized = append(customized, decl)
+		}
+		for _, decl := range customizedGenDecls(f, declaredNames(generatedFile)) {
+			customized = append(customized, decl)
+		}
+		headerComments, comments := customizedComments(currentFset, f, commentTexts(generatedFile))
+		header = headerComments
+		for _, comment := range comments {
+			customized = append(customized, comment)
+		}
+		sort.SliceStable(customized, func(i, j int) bool { return customized[i].Pos() < customized[j].Pos() })
+	}
+	customizedKeys := map[string]struct{}{}
+	for _, decl := range customizedFuncDecls {
+		customizedKeys[funcKey(decl)] = struct{}{}
+	}
+
+	type edit struct {
+		start, end int
+		text       string
+	}
+	edits := []edit{}
+	for _, decl := range generatedFile.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if _, exists := customizedKeys[funcKey(d)]; exists {
+				start, end := declRange(generatedFset, d)
+				edits = append(edits, edit{start: start, end: end})
+				continue
+			}
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				continue
+			}
+		}
+		pos := generatedFset.Position(decl.Pos()).Offset
+		edits = append(edits, edit{start: pos, end: pos, text: "// " + FuncGeneratedMarker + "\n"})
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	var buf bytes.Buffer
+	for _, comment := range header {
+		buf.Write(current[currentFset.Position(comment.Pos()).Offset:currentFset.Position(comment.End()).Offset])
+		buf.WriteString("\n\n")
+	}
+	offset := 0
+	for _, e := range edits {
+		buf.Write(generated[offset:e.start])
+		buf.WriteString(e.text)
+		offset = e.end
+	}
+	buf.Write(generated[offset:])
+	for _, node := range customized {
+		start, end := currentFset.Position(node.Pos()).Offset, currentFset.Position(node.End()).Offset
+		if decl, ok := node.(ast.Decl); ok {
+			start, end = declRange(currentFset, decl)
+		}
+		buf.WriteString("\n")
+		buf.Write(current[start:end])
+		buf.WriteString("\n")
+	}
+	if len(customized) == 0 && len(header) == 0 {
+		return format.Source(buf.Bytes())
+	}
+
+	// add imports of current source. unused ones are removed by goimports
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse merged source: %w", err)
+	}
+	for _, spec := range currentFile.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unquote from %s: %w", spec.Path.Value, err)
+		}
+		name := ""
+		if spec.Name != nil {
+			name = spec.Name.String()
+		}
+		astutil.AddNamedImport(fset, f, name, path)
+	}
+	var merged bytes.Buffer
+	if err := format.Node(&merged, fset, f); err != nil {
+		return nil, xerrors.Errorf("cannot format merged source: %w", err)
+	}
+	source, err := imports.Process("", merged.Bytes(), nil)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot format merged source by goimports: %w", err)
+	}
+	return source, nil
+}
@@ -5,16 +5,20 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/goccy/go-yaml"
+	"go.knocknote.io/eevee/output"
 	"go.knocknote.io/eevee/plural"
 	"go.knocknote.io/eevee/types"
 	"golang.org/x/xerrors"
 )
 
 const (
-	ConfigFilePath   = ".eevee.yml"
-	DefaultDataStore = "db"
+	ConfigFilePath       = ".eevee.yml"
+	DefaultDataStore     = "db"
+	DefaultDialect       = types.DialectMySQL
+	DefaultMigrationPath = "migrations"
 )
 
 type Plugin struct {
@@ -32,22 +36,49 @@ type PrimitiveType struct {
 }
 
 type Config struct {
-	ModulePath   string             `yaml:"module"`
-	ClassPath    string             `yaml:"class,omitempty"`
-	APIPath      string             `yaml:"api,omitempty"`
-	SchemaPath   string             `yaml:"schema,omitempty"`
-	GraphPath    string             `yaml:"graph,omitempty"`
-	DocumentPath string             `yaml:"document,omitempty"`
-	OutputPath   string             `yaml:"output,omitempty"`
-	Plugins      map[string]*Plugin `yaml:"plugins,omitempty"`
-	DAO          *DAO               `yaml:"dao,omitempty"`
-	Entity       *Entity            `yaml:"entity,omitempty"`
-	Model        *Model             `yaml:"model,omitempty"`
-	Repository   *Repository        `yaml:"repository,omitempty"`
-	Renderer     *Renderer          `yaml:"renderer,omitempty"`
-	Plural       []*Plural          `yaml:"plural,omitempty"`
-	Context      *Context           `yaml:"context,omitempty"`
-	Types        []*PrimitiveType   `yaml:"primitive_types,omitempty"`
+	ModulePath    string             `yaml:"module"`
+	ClassPath     string             `yaml:"class,omitempty"`
+	APIPath       string             `yaml:"api,omitempty"`
+	SchemaPath    string             `yaml:"schema,omitempty"`
+	SchemaDSN     string             `yaml:"schema_dsn,omitempty"`
+	SchemaDriver  string             `yaml:"schema_driver,omitempty"`
+	Dialect       types.Dialect      `yaml:"dialect,omitempty"`
+	GraphPath     string             `yaml:"graph,omitempty"`
+	DocumentPath  string             `yaml:"document,omitempty"`
+	MigrationPath string             `yaml:"migration,omitempty"`
+	OutputPath    string             `yaml:"output,omitempty"`
+	Plugins       map[string]*Plugin `yaml:"plugins,omitempty"`
+	DAO           *DAO               `yaml:"dao,omitempty"`
+	Entity        *Entity            `yaml:"entity,omitempty"`
+	Model         *Model             `yaml:"model,omitempty"`
+	Repository    *Repository        `yaml:"repository,omitempty"`
+	Renderer      *Renderer          `yaml:"renderer,omitempty"`
+	Plural        []*Plural          `yaml:"plural,omitempty"`
+	Context       *Context           `yaml:"context,omitempty"`
+	Types         []*PrimitiveType   `yaml:"primitive_types,omitempty"`
+	TypeMapping   TypeMapping        `yaml:"type_mapping,omitempty"`
+	Writer        output.Writer      `yaml:"-"`
+}
+
+// OutputWriter returns writer for generated files. default writer writes files to disk.
+func (cfg *Config) OutputWriter() output.Writer {
+	if cfg.Writer == nil {
+		return output.NewFileWriter()
+	}
+	return cfg.Writer
+}
+
+// ReadsSchemaFromDatabase whether schema is read from live database specified by schema_dsn instead of schema files
+func (cfg *Config) ReadsSchemaFromDatabase() bool {
+	return cfg.SchemaDSN != ""
+}
+
+// MigrationOutputPath returns directory to write migration files. default is migrations.
+func (cfg *Config) MigrationOutputPath() string {
+	if cfg.MigrationPath == "" {
+		return DefaultMigrationPath
+	}
+	return cfg.MigrationPath
 }
 
 func (cfg *Config) OutputPathWithPackage(pkg string) string {
@@ -126,6 +157,38 @@ func (cfg *Config) DataStore() string {
 	return DefaultDataStore
 }
 
+// UseUpdateMap whether UpdateBy methods receive map[string]interface{} instead of entity for updated columns.
+// it is for backward compatibility.
+func (cfg *Config) UseUpdateMap() bool {
+	return cfg.DAO != nil && cfg.DAO.UpdateMap
+}
+
+// DefaultCreateMultiChunkSize max number of rows inserted by one query in CreateMulti if it isn't specified
+const DefaultCreateMultiChunkSize = 100
+
+// CreateMultiChunkSize max number of rows inserted by one query in CreateMulti
+func (cfg *Config) CreateMultiChunkSize() int {
+	if cfg.DAO == nil || cfg.DAO.CreateMultiChunkSize <= 0 {
+		return DefaultCreateMultiChunkSize
+	}
+	return cfg.DAO.CreateMultiChunkSize
+}
+
+func (cfg *Config) SQLDialect() types.Dialect {
+	switch strings.ToLower(string(cfg.Dialect)) {
+	case "postgres", "postgresql", "pg":
+		return types.DialectPostgres
+	case "mysql":
+		return types.DialectMySQL
+	case "sqlite", "sqlite3":
+		return types.DialectSQLite
+	}
+	if cfg.Dialect == "" && cfg.DataStore() == "sqlite" {
+		return types.DialectSQLite
+	}
+	return DefaultDialect
+}
+
 func (cfg *Config) EntityPlugins() []string {
 	if cfg.Entity == nil {
 		return nil
@@ -150,6 +213,9 @@ type DAO struct {
 	Name      string                `yaml:"name,omitempty"`
 	Default   string                `yaml:"default,omitempty"`
 	DataStore map[string]*DataStore `yaml:"datastore,omitempty"`
+	UpdateMap bool                  `yaml:"update_map,omitempty"`
+	// CreateMultiChunkSize max number of rows inserted by one query in CreateMulti
+	CreateMultiChunkSize int `yaml:"create_multi_chunk_size,omitempty"`
 }
 
 type Entity struct {
@@ -177,6 +243,10 @@ type Renderer struct {
 	Style RenderStyle `yaml:"style,omitempty"`
 }
 
+// TypeMapping overrides Go type converted from column type.
+// key is column type ( e.g. decimal, tinyint(1) ) or column name with table name ( e.g. users.score )
+type TypeMapping map[string]*types.TypeDeclare
+
 type Plural struct {
 	Name string `yaml:"name"`
 	One  string `yaml:"one"`
@@ -41,4 +41,21 @@ context:
 	if cfg.OutputPathWithPackage("entity") != filepath.Join(".", "entity") {
 		t.Fatalf("failed to get output path with package: %s", cfg.OutputPathWithPackage("entity"))
 	}
+	if cfg.SchemaPath != "schema" || cfg.ReadsSchemaFromDatabase() {
+		t.Fatalf("failed to get schema path: %s", cfg.SchemaPath)
+	}
+}
+
+func TestSchemaFromDatabase(t *testing.T) {
+	cfg, err := config.ConfigFromBytes([]byte(`
+module: test
+dialect: postgres
+schema_dsn: postgres://localhost/test?sslmode=disable
+`))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !cfg.ReadsSchemaFromDatabase() || cfg.SchemaDSN != "postgres://localhost/test?sslmode=disable" || cfg.SchemaPath != "" {
+		t.Fatalf("failed to get schema from database: %s", cfg.SchemaDSN)
+	}
 }
@@ -7,9 +7,7 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
-	"io/ioutil"
 	"log"
-	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -36,6 +34,7 @@ type Generator struct {
 	receiverName string
 	importList   types.ImportList
 	datastores   map[string]*DataStore
+	dialect      types.Dialect
 	cfg          *config.Config
 }
 
@@ -107,10 +106,14 @@ func (s *DataStore) hookByPoint(point string, pluginName interface{}) error {
 	maps := []map[string]func() error{
 		s.hookMap("constructor", pluginName),
 		s.hookMap("create", pluginName),
+		s.hookMap("create-multi", pluginName),
+		s.hookMap("upsert", pluginName),
+		s.hookMap("upsert-multi", pluginName),
 		s.hookMap("update", pluginName),
 		s.hookMap("delete", pluginName),
 		s.hookMap("find-all", pluginName),
 		s.hookMap("count", pluginName),
+		s.hookMap("query", pluginName),
 		s.hookMap("findby", pluginName),
 		s.hookMap("findby-plural", pluginName),
 		s.hookMap("updateby", pluginName),
@@ -139,6 +142,18 @@ func NewPluginMap() map[string][]func(types.DAOContext) ([]Code, error) {
 		BeforeCreatePlugin:          []func(types.DAOContext) ([]Code, error){},
 		CreatePlugin:                []func(types.DAOContext) ([]Code, error){},
 		AfterCreatePlugin:           []func(types.DAOContext) ([]Code, error){},
+		CreateMultiDeclarePlugin:    []func(types.DAOContext) ([]Code, error){},
+		BeforeCreateMultiPlugin:     []func(types.DAOContext) ([]Code, error){},
+		CreateMultiPlugin:           []func(types.DAOContext) ([]Code, error){},
+		AfterCreateMultiPlugin:      []func(types.DAOContext) ([]Code, error){},
+		UpsertDeclarePlugin:         []func(types.DAOContext) ([]Code, error){},
+		BeforeUpsertPlugin:          []func(types.DAOContext) ([]Code, error){},
+		UpsertPlugin:                []func(types.DAOContext) ([]Code, error){},
+		AfterUpsertPlugin:           []func(types.DAOContext) ([]Code, error){},
+		UpsertMultiDeclarePlugin:    []func(types.DAOContext) ([]Code, error){},
+		BeforeUpsertMultiPlugin:     []func(types.DAOContext) ([]Code, error){},
+		UpsertMultiPlugin:           []func(types.DAOContext) ([]Code, error){},
+		AfterUpsertMultiPlugin:      []func(types.DAOContext) ([]Code, error){},
 		UpdateDeclarePlugin:         []func(types.DAOContext) ([]Code, error){},
 		BeforeUpdatePlugin:          []func(types.DAOContext) ([]Code, error){},
 		UpdatePlugin:                []func(types.DAOContext) ([]Code, error){},
@@ -155,6 +170,10 @@ func NewPluginMap() map[string][]func(types.DAOContext) ([]Code, error) {
 		BeforeCountPlugin:           []func(types.DAOContext) ([]Code, error){},
 		CountPlugin:                 []func(types.DAOContext) ([]Code, error){},
 		AfterCountPlugin:            []func(types.DAOContext) ([]Code, error){},
+		QueryDeclarePlugin:          []func(types.DAOContext) ([]Code, error){},
+		BeforeQueryPlugin:           []func(types.DAOContext) ([]Code, error){},
+		QueryPlugin:                 []func(types.DAOContext) ([]Code, error){},
+		AfterQueryPlugin:            []func(types.DAOContext) ([]Code, error){},
 		FindByDeclarePlugin:         []func(types.DAOContext) ([]Code, error){},
 		BeforeFindByPlugin:          []func(types.DAOContext) ([]Code, error){},
 		FindByPlugin:                []func(types.DAOContext) ([]Code, error){},
@@ -189,6 +208,7 @@ func NewGenerator(cfg *config.Config) *Generator {
 		receiverName: "d",
 		datastores:   map[string]*DataStore{},
 		importList:   types.ImportList{},
+		dialect:      cfg.SQLDialect(),
 		cfg:          cfg,
 	}
 	g.buildDataStores()
@@ -238,9 +258,19 @@ func (g *Generator) newDataAccessParam(class *types.Class) types.DataAccessParam
 		ClassName:  func() *Statement { return Id(class.Name.CamelName()) },
 		Receiver:   func() *Statement { return Id(g.receiverName) },
 		ImportList: g.importList,
+		Dialect:    g.dialectByClass(class),
 	}
 }
 
+// dialectByClass SQL dialect for class.
+// datastore that requires specific dialect ( e.g. sqlite ) takes precedence over configuration.
+func (g *Generator) dialectByClass(class *types.Class) types.Dialect {
+	if dialect := DataStoreByName(class.DataStore).Dialect(); dialect != "" {
+		return dialect
+	}
+	return g.dialect
+}
+
 func (g *Generator) newConstructorParam(class *types.Class) *types.ConstructorParam {
 	return &types.ConstructorParam{
 		DataAccessParam: g.newDataAccessParam(class),
@@ -261,14 +291,56 @@ func (g *Generator) newCreateParam(class *types.Class) *types.CreateParam {
 	}
 }
 
-func (g *Generator) newUpdateParam(class *types.Class) *types.UpdateParam {
-	return &types.UpdateParam{
+func (g *Generator) newCreateMultiParam(class *types.Class) *types.CreateMultiParam {
+	return &types.CreateMultiParam{
 		DataAccessParam: g.newDataAccessParam(class),
-		Args: &types.UpdateParamArgs{
-			Context:   func() *Statement { return Id("ctx") },
-			Value:     func() *Statement { return Id("value") },
-			UpdateMap: func() *Statement { return Id("updateMap") },
+		Args: &types.CreateMultiParamArgs{
+			Context: func() *Statement { return Id("ctx") },
+			Values:  func() *Statement { return Id("values") },
+			Value:   func() *Statement { return Id("value") },
+		},
+		ChunkSize: g.cfg.CreateMultiChunkSize(),
+	}
+}
+
+func (g *Generator) newUpsertParam(class *types.Class, conflictMembers types.Members) *types.UpsertParam {
+	return &types.UpsertParam{
+		DataAccessParam: g.newDataAccessParam(class),
+		Args: &types.CreateParamArgs{
+			Context: func() *Statement { return Id("ctx") },
+			Value:   func() *Statement { return Id("value") },
 		},
+		ConflictMembers: conflictMembers,
+	}
+}
+
+func (g *Generator) newUpsertMultiParam(class *types.Class, conflictMembers types.Members) *types.UpsertMultiParam {
+	return &types.UpsertMultiParam{
+		DataAccessParam: g.newDataAccessParam(class),
+		Args: &types.CreateMultiParamArgs{
+			Context: func() *Statement { return Id("ctx") },
+			Values:  func() *Statement { return Id("values") },
+			Value:   func() *Statement { return Id("value") },
+		},
+		ChunkSize:       g.cfg.CreateMultiChunkSize(),
+		ConflictMembers: conflictMembers,
+	}
+}
+
+func (g *Generator) newUpdateParam(class *types.Class) *types.UpdateParam {
+	args := &types.UpdateParamArgs{
+		Context: func() *Statement { return Id("ctx") },
+		Value:   func() *Statement { return Id("value") },
+	}
+	if g.cfg.UseUpdateMap() {
+		args.UpdateMap = func() *Statement { return Id("updateMap") }
+	} else {
+		args.UpdateValue = func() *Statement { return Id("updateValue") }
+	}
+	return &types.UpdateParam{
+		DataAccessParam:   g.newDataAccessParam(class),
+		Args:              args,
+		LockVersionMember: class.LockVersionMember(),
 	}
 }
 
@@ -292,6 +364,21 @@ func (g *Generator) newFindParam(class *types.Class) *types.FindParam {
 	}
 }
 
+// newFindParamsForMultipleRecords returns parameters for method to find multiple records by members.
+// it contains parameter for the variant with option if records of class are able to be sorted.
+func (g *Generator) newFindParamsForMultipleRecords(class *types.Class, members types.Members) []*types.FindParam {
+	p := g.newFindParam(class)
+	p.Args.Members = append(p.Args.Members, members...)
+	params := []*types.FindParam{p}
+	if len(class.OrderMembers()) == 0 {
+		return params
+	}
+	p = g.newFindParam(class)
+	p.Args.Members = append(p.Args.Members, members...)
+	p.Args.Option = func() *Statement { return Id("opt") }
+	return append(params, p)
+}
+
 func (g *Generator) newCountParam(class *types.Class) *types.CountParam {
 	return &types.CountParam{
 		DataAccessParam: g.newDataAccessParam(class),
@@ -302,6 +389,17 @@ func (g *Generator) newCountParam(class *types.Class) *types.CountParam {
 	}
 }
 
+func (g *Generator) newQueryParam(class *types.Class, query *types.Query) *types.QueryParam {
+	return &types.QueryParam{
+		DataAccessParam: g.newDataAccessParam(class),
+		Args: &types.QueryParamArgs{
+			Context: func() *Statement { return Id("ctx") },
+		},
+		Query:               query,
+		IsSingleReturnValue: query.IsSingleReturnValue(),
+	}
+}
+
 func (g *Generator) newConstructorDeclare(class *types.Class) (*types.ConstructorDeclare, error) {
 	declare := &types.ConstructorDeclare{
 		Class:      class,
@@ -331,13 +429,14 @@ func (g *Generator) newConstructorDeclare(class *types.Class) (*types.Constructo
 	return declare, nil
 }
 
-func (g *Generator) newCreateDeclare(class *types.Class) (*types.MethodDeclare, error) {
-	declare := &types.MethodDeclare{
+// newValueDeclare returns declaration of method that receives an entity ( e.g. Create(ctx, *entity.User) )
+func (g *Generator) newValueDeclare(class *types.Class, methodName string) *types.MethodDeclare {
+	return &types.MethodDeclare{
 		Class:             class,
 		ReceiverName:      g.receiverName,
 		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
 		ImportList:        g.importList,
-		MethodName:        "Create",
+		MethodName:        methodName,
 		Args: types.ValueDeclares{
 			{
 				Name: "ctx",
@@ -364,6 +463,10 @@ func (g *Generator) newCreateDeclare(class *types.Class) (*types.MethodDeclare,
 			},
 		},
 	}
+}
+
+func (g *Generator) newCreateDeclare(class *types.Class) (*types.MethodDeclare, error) {
+	declare := g.newValueDeclare(class, "Create")
 	datastore := g.datastores[class.DataStore]
 	for _, fn := range datastore.pluginMap[CreateDeclarePlugin] {
 		if _, err := fn(declare); err != nil {
@@ -373,6 +476,72 @@ func (g *Generator) newCreateDeclare(class *types.Class) (*types.MethodDeclare,
 	return declare, nil
 }
 
+// newValuesDeclare returns declaration of method that receives entities ( e.g. CreateMulti(ctx, entity.Users) )
+func (g *Generator) newValuesDeclare(class *types.Class, methodName string) *types.MethodDeclare {
+	return &types.MethodDeclare{
+		Class:             class,
+		ReceiverName:      g.receiverName,
+		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
+		ImportList:        g.importList,
+		MethodName:        methodName,
+		Args: types.ValueDeclares{
+			{
+				Name: "ctx",
+				Type: types.TypeDeclareWithType(&types.Type{
+					PackageName: g.importList.Package("context"),
+					Name:        "Context",
+				}),
+			},
+			{
+				Name: "values",
+				Type: types.TypeDeclareWithType(&types.Type{
+					PackageName: g.importList.Package("entity"),
+					Name:        class.Name.PluralCamelName(),
+				}),
+			},
+		},
+		Return: []*types.ValueDeclare{
+			{
+				Name: "e",
+				Type: types.TypeDeclareWithType(types.ErrorType),
+			},
+		},
+	}
+}
+
+func (g *Generator) newCreateMultiDeclare(class *types.Class) (*types.MethodDeclare, error) {
+	declare := g.newValuesDeclare(class, "CreateMulti")
+	datastore := g.datastores[class.DataStore]
+	for _, fn := range datastore.pluginMap[CreateMultiDeclarePlugin] {
+		if _, err := fn(declare); err != nil {
+			return nil, xerrors.Errorf("failed to declaration for create-multi: %w", err)
+		}
+	}
+	return declare, nil
+}
+
+func (g *Generator) newUpsertDeclare(class *types.Class) (*types.MethodDeclare, error) {
+	declare := g.newValueDeclare(class, "Upsert")
+	datastore := g.datastores[class.DataStore]
+	for _, fn := range datastore.pluginMap[UpsertDeclarePlugin] {
+		if _, err := fn(declare); err != nil {
+			return nil, xerrors.Errorf("failed to declaration for upsert: %w", err)
+		}
+	}
+	return declare, nil
+}
+
+func (g *Generator) newUpsertMultiDeclare(class *types.Class) (*types.MethodDeclare, error) {
+	declare := g.newValuesDeclare(class, "UpsertMulti")
+	datastore := g.datastores[class.DataStore]
+	for _, fn := range datastore.pluginMap[UpsertMultiDeclarePlugin] {
+		if _, err := fn(declare); err != nil {
+			return nil, xerrors.Errorf("failed to declaration for upsert-multi: %w", err)
+		}
+	}
+	return declare, nil
+}
+
 func (g *Generator) newUpdateDeclare(class *types.Class) (*types.MethodDeclare, error) {
 	declare := &types.MethodDeclare{
 		Class:             class,
@@ -457,14 +626,14 @@ func (g *Generator) newDeleteDeclare(class *types.Class) (*types.MethodDeclare,
 	return declare, nil
 }
 
-func (g *Generator) newFindAllDeclare(class *types.Class) (*types.MethodDeclare, error) {
+func (g *Generator) newFindAllDeclare(class *types.Class, p *types.FindParam) (*types.MethodDeclare, error) {
 	declare := &types.MethodDeclare{
 		Class:             class,
 		ReceiverName:      g.receiverName,
 		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
 		ImportList:        g.importList,
-		MethodName:        "FindAll",
-		Args: types.ValueDeclares{
+		MethodName:        g.findMethodName(p, "FindAll"),
+		Args: g.appendFindOptionArg(p, types.ValueDeclares{
 			{
 				Name: "ctx",
 				Type: types.TypeDeclareWithType(&types.Type{
@@ -472,7 +641,7 @@ func (g *Generator) newFindAllDeclare(class *types.Class) (*types.MethodDeclare,
 					Name:        "Context",
 				}),
 			},
-		},
+		}),
 		Return: []*types.ValueDeclare{
 			{
 				Name: "r",
@@ -532,6 +701,93 @@ func (g *Generator) newCountDeclare(class *types.Class) (*types.MethodDeclare, e
 	return declare, nil
 }
 
+func (g *Generator) newQueryDeclare(class *types.Class, p *types.QueryParam) (*types.MethodDeclare, error) {
+	args := types.ValueDeclares{
+		{
+			Name: "ctx",
+			Type: types.TypeDeclareWithType(&types.Type{
+				PackageName: g.importList.Package("context"),
+				Name:        "Context",
+			}),
+		},
+	}
+	for idx, param := range p.Query.Params {
+		args = append(args, &types.ValueDeclare{
+			Name: fmt.Sprintf("a%d", idx),
+			Type: param.Type,
+		})
+	}
+	returnType := types.TypeDeclareWithType(&types.Type{
+		PackageName: g.importList.Package("entity"),
+		Name:        class.Name.PluralCamelName(),
+	})
+	if p.IsSingleReturnValue {
+		returnType = &types.TypeDeclare{
+			Type: &types.Type{
+				PackageName: g.importList.Package("entity"),
+				Name:        class.Name.CamelName(),
+			},
+			IsPointer: true,
+		}
+	}
+	declare := &types.MethodDeclare{
+		Class:             class,
+		ReceiverName:      g.receiverName,
+		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
+		ImportList:        g.importList,
+		MethodName:        p.Query.MethodName(),
+		Args:              args,
+		Return: []*types.ValueDeclare{
+			{
+				Name: "r",
+				Type: returnType,
+			},
+			{
+				Name: "e",
+				Type: types.TypeDeclareWithType(types.ErrorType),
+			},
+		},
+	}
+	datastore := g.datastores[class.DataStore]
+	for _, fn := range datastore.pluginMap[QueryDeclarePlugin] {
+		if _, err := fn(declare); err != nil {
+			return nil, xerrors.Errorf("failed to declaration for query: %w", err)
+		}
+	}
+	return declare, nil
+}
+
+// findMethodName returns name of method to find records.
+// ForUpdate or ForShare suffix is added to name of locking read ( e.g. FindByIDForUpdate ).
+// WithOption suffix is added to name of the variant with option ( e.g. FindByGroupIDWithOption ).
+func (g *Generator) findMethodName(p *types.FindParam, name string) string {
+	if p.Args.Lock != "" {
+		name += p.Args.Lock.MethodSuffix()
+	}
+	if p.Args.Option == nil {
+		return name
+	}
+	return fmt.Sprintf("%sWithOption", name)
+}
+
+// appendFindOptionArg appends argument to specify order and range of found records ( e.g. *entity.UserFindOption )
+// if method is variant with option.
+func (g *Generator) appendFindOptionArg(p *types.FindParam, args types.ValueDeclares) types.ValueDeclares {
+	if p.Args.Option == nil {
+		return args
+	}
+	return append(args, &types.ValueDeclare{
+		Name: "opt",
+		Type: &types.TypeDeclare{
+			Type: &types.Type{
+				PackageName: g.importList.Package("entity"),
+				Name:        p.Class.FindOptionStructName(),
+			},
+			IsPointer: true,
+		},
+	})
+}
+
 func (g *Generator) newFindByDeclare(class *types.Class, p *types.FindParam) (*types.MethodDeclare, error) {
 	args := types.ValueDeclares{
 		{
@@ -555,7 +811,7 @@ func (g *Generator) newFindByDeclare(class *types.Class, p *types.FindParam) (*t
 		ReceiverName:      g.receiverName,
 		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
 		ImportList:        g.importList,
-		MethodName:        fmt.Sprintf("FindBy%s", strings.Join(argsCamelNames, "And")),
+		MethodName:        g.findMethodName(p, fmt.Sprintf("FindBy%s", strings.Join(argsCamelNames, "And"))),
 		ArgMembers:        p.Args.Members,
 		Args:              args,
 		Return: []*types.ValueDeclare{
@@ -602,13 +858,25 @@ func (g *Generator) newFindBySliceDeclare(class *types.Class, p *types.FindParam
 			Type: member.Type,
 		})
 	}
+	methodName := fmt.Sprintf("FindBy%s", strings.Join(argsCamelNames, "And"))
+	if p.Args.Range != "" {
+		// the rest of arguments to compare the last member ( e.g. upper bound of BETWEEN )
+		lastMember := p.Args.Members[len(p.Args.Members)-1]
+		for i := 1; i < p.Args.Range.ArgNum(); i++ {
+			args = append(args, &types.ValueDeclare{
+				Name: fmt.Sprintf("a%d", len(args)-1),
+				Type: lastMember.Type,
+			})
+		}
+		methodName += p.Args.Range.MethodSuffix()
+	}
 	declare := &types.MethodDeclare{
 		Class:             class,
 		ReceiverName:      g.receiverName,
 		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
 		ImportList:        g.importList,
-		MethodName:        fmt.Sprintf("FindBy%s", strings.Join(argsCamelNames, "And")),
-		Args:              args,
+		MethodName:        g.findMethodName(p, methodName),
+		Args:              g.appendFindOptionArg(p, args),
 		ArgMembers:        p.Args.Members,
 		Return: []*types.ValueDeclare{
 			{
@@ -640,9 +908,9 @@ func (g *Generator) newFindByPluralDeclare(class *types.Class, p *types.FindPara
 		ReceiverName:      g.receiverName,
 		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
 		ImportList:        g.importList,
-		MethodName:        fmt.Sprintf("FindBy%s", member.Name.PluralCamelName()),
+		MethodName:        g.findMethodName(p, fmt.Sprintf("FindBy%s", member.Name.PluralCamelName())),
 		ArgMembers:        p.Args.Members,
-		Args: types.ValueDeclares{
+		Args: g.appendFindOptionArg(p, types.ValueDeclares{
 			{
 				Name: "ctx",
 				Type: types.TypeDeclareWithType(&types.Type{
@@ -657,7 +925,7 @@ func (g *Generator) newFindByPluralDeclare(class *types.Class, p *types.FindPara
 					IsSlice: true,
 				},
 			},
-		},
+		}),
 		Return: []*types.ValueDeclare{
 			{
 				Name: "r",
@@ -681,6 +949,27 @@ func (g *Generator) newFindByPluralDeclare(class *types.Class, p *types.FindPara
 	return declare, nil
 }
 
+// updateArg returns argument of UpdateBy methods to specify updated columns.
+// it is entity for class ( e.g. *entity.UserUpdate ) or map[string]interface{} if `dao.update_map` is enabled.
+func (g *Generator) updateArg(class *types.Class) *types.ValueDeclare {
+	if g.cfg.UseUpdateMap() {
+		return &types.ValueDeclare{
+			Name: "updateMap",
+			Type: types.TypeDeclareWithName("map[string]interface{}"),
+		}
+	}
+	return &types.ValueDeclare{
+		Name: "updateValue",
+		Type: &types.TypeDeclare{
+			Type: &types.Type{
+				PackageName: g.importList.Package("entity"),
+				Name:        class.UpdateStructName(),
+			},
+			IsPointer: true,
+		},
+	}
+}
+
 func (g *Generator) newUpdateByDeclare(class *types.Class, p *types.UpdateParam) (*types.MethodDeclare, error) {
 	args := types.ValueDeclares{
 		{
@@ -699,10 +988,7 @@ func (g *Generator) newUpdateByDeclare(class *types.Class, p *types.UpdateParam)
 			Type: member.Type,
 		})
 	}
-	args = append(args, &types.ValueDeclare{
-		Name: "updateMap",
-		Type: types.TypeDeclareWithName("map[string]interface{}"),
-	})
+	args = append(args, g.updateArg(class))
 	declare := &types.MethodDeclare{
 		Class:             class,
 		ReceiverName:      g.receiverName,
@@ -751,10 +1037,7 @@ func (g *Generator) newUpdateByPluralDeclare(class *types.Class, p *types.Update
 					IsSlice: true,
 				},
 			},
-			{
-				Name: "updateMap",
-				Type: types.TypeDeclareWithName("map[string]interface{}"),
-			},
+			g.updateArg(class),
 		},
 		Return: types.ValueDeclares{
 			{
@@ -867,6 +1150,16 @@ func (g *MethodGenerator) Generate(importList types.ImportList) *Statement {
 
 type MethodGenerators []*MethodGenerator
 
+// hasUpdateBy whether UpdateBy or UpdateByPlural is generated.
+func (gens MethodGenerators) hasUpdateBy() bool {
+	for _, gen := range gens {
+		if strings.HasPrefix(gen.decl.MethodName, "UpdateBy") {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *Generator) getHookCodes(class *types.Class, kind string, param types.DAOContext) []Code {
 	blocks := []Code{}
 	datastore := g.datastores[class.DataStore]
@@ -889,7 +1182,28 @@ func (g *Generator) getHookCodes(class *types.Class, kind string, param types.DA
 	return blocks
 }
 
+// primaryKeys returns members to identify a record for Update and Delete.
+// if primary key is not declared, id member is used.
+func (g *Generator) primaryKeys(class *types.Class) types.Members {
+	if primaryKeys := class.PrimaryKeys(); len(primaryKeys) > 0 {
+		return primaryKeys
+	}
+	if member := class.MemberByName("id"); member != nil {
+		return types.Members{member}
+	}
+	return types.Members{}
+}
+
+func (g *Generator) condition(dialect types.Dialect, member *types.Member, argIndex int) string {
+	return fmt.Sprintf("%s = %s", dialect.Quote(member.Name.SnakeName()), dialect.Placeholder(argIndex))
+}
+
+func (g *Generator) scanValue(dialect types.Dialect, member *types.Member) Code {
+	return dialect.ValueCode(member.Type, Op("&").Id("value").Dot(member.Name.CamelName()))
+}
+
 func (g *Generator) newCreateMethodGenerator(class *types.Class) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newCreateDeclare(class)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for create: %w", err)
@@ -897,7 +1211,10 @@ func (g *Generator) newCreateMethodGenerator(class *types.Class) (*MethodGenerat
 	param := g.newCreateParam(class)
 	placeholders := []string{}
 	columns := []string{}
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	args := []Code{}
+	scanValues := []Code{}
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
+	var returningMember *types.Member
 	for _, member := range class.Members {
 		if member.Relation != nil {
 			continue
@@ -905,29 +1222,241 @@ func (g *Generator) newCreateMethodGenerator(class *types.Class) (*MethodGenerat
 		if member.Extend {
 			continue
 		}
-		placeholders = append(placeholders, "?")
-		columns = append(columns, fmt.Sprintf("`%s`", member.Name))
+		if dialect.OmitsGeneratedID() && member.AutoIncrement {
+			// let database assign the value by serial, identity or rowid column
+			if dialect.SupportsReturning() {
+				returningMember = member
+			}
+			continue
+		}
+		placeholders = append(placeholders, dialect.Placeholder(len(placeholders)+1))
+		columns = append(columns, dialect.Quote(string(member.Name)))
+		args = append(args, dialect.ValueCode(member.Type, param.Args.Value().Dot(member.Name.CamelName())))
 	}
 	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s)`,
 		escapedTableName,
 		strings.Join(columns, ", "),
 		strings.Join(placeholders, ", "),
 	)
-	param.SQL = &types.SQL{Query: query}
+	if returningMember != nil {
+		query += fmt.Sprintf(" RETURNING %s", dialect.Quote(returningMember.Name.SnakeName()))
+		scanValues = append(scanValues, Op("&").Add(param.Args.Value()).Dot(returningMember.Name.CamelName()))
+	}
+	param.SQL = &types.SQL{
+		Query:      query,
+		Args:       args,
+		ScanValues: scanValues,
+	}
 	return &MethodGenerator{
 		decl:  decl,
 		hooks: g.getHookCodes(class, "create", param),
 	}, nil
 }
 
+// insertMembers returns members inserted by INSERT statement.
+// returningMember is auto increment member that is omitted from INSERT statement and returned by INSERT ... RETURNING.
+func (g *Generator) insertMembers(dialect types.Dialect, class *types.Class) (types.Members, *types.Member) {
+	members := types.Members{}
+	var returningMember *types.Member
+	for _, member := range class.ColumnMembers() {
+		if dialect.OmitsGeneratedID() && member.AutoIncrement {
+			// let database assign the value by serial, identity or rowid column
+			if dialect.SupportsReturning() {
+				returningMember = member
+			}
+			continue
+		}
+		members = append(members, member)
+	}
+	return members, returningMember
+}
+
+func (g *Generator) newCreateMultiMethodGenerator(class *types.Class) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
+	decl, err := g.newCreateMultiDeclare(class)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to declaration for create-multi: %w", err)
+	}
+	param := g.newCreateMultiParam(class)
+	param.SQL = g.insertMultiSQL(dialect, class, param.Args.Value, "")
+	return &MethodGenerator{
+		decl:  decl,
+		hooks: g.getHookCodes(class, "create-multi", param),
+	}, nil
+}
+
+// insertMultiSQL returns multi-row INSERT statement that has %s for placeholders of rows.
+// placeholders are built at runtime because number of rows is variable.
+// clause is added after VALUES ( e.g. ON DUPLICATE KEY UPDATE ).
+func (g *Generator) insertMultiSQL(dialect types.Dialect, class *types.Class, value func() *Statement, clause string) *types.SQL {
+	members, returningMember := g.insertMembers(dialect, class)
+	columns := []string{}
+	args := []Code{}
+	scanValues := []Code{}
+	for _, member := range members {
+		columns = append(columns, dialect.Quote(string(member.Name)))
+		args = append(args, dialect.ValueCode(member.Type, value().Dot(member.Name.CamelName())))
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES %%s`,
+		dialect.Quote(class.Name.PluralSnakeName()),
+		strings.Join(columns, ", "),
+	)
+	if clause != "" {
+		query += " " + clause
+	}
+	if returningMember != nil {
+		query += fmt.Sprintf(" RETURNING %s", dialect.Quote(returningMember.Name.SnakeName()))
+		scanValues = append(scanValues, Op("&").Add(value()).Dot(returningMember.Name.CamelName()))
+	}
+	return &types.SQL{
+		Query:      query,
+		Args:       args,
+		ScanValues: scanValues,
+	}
+}
+
+// upsertKey returns members to find existing record by Upsert.
+// unique key takes precedence over primary key, and auto increment primary key is not used
+// because its value is unknown before the record is inserted.
+func (g *Generator) upsertKey(class *types.Class) types.Members {
+	if uniqueKeys := class.UniqueKeys(); len(uniqueKeys) > 0 {
+		return uniqueKeys[0]
+	}
+	primaryKeys := class.PrimaryKeys()
+	for _, member := range primaryKeys {
+		if member.AutoIncrement {
+			return nil
+		}
+	}
+	return primaryKeys
+}
+
+// upsertClause returns clause to update existing record for columns except conflictMembers, auto increment member and created_at member.
+// member with lock_version is incremented instead of being overwritten by inserted value.
+func (g *Generator) upsertClause(dialect types.Dialect, class *types.Class, conflictMembers types.Members, autoIncrementColumn string) string {
+	conflictColumns := []string{}
+	conflictColumnMap := map[string]struct{}{}
+	for _, member := range conflictMembers {
+		conflictColumns = append(conflictColumns, member.Name.SnakeName())
+		conflictColumnMap[member.Name.SnakeName()] = struct{}{}
+	}
+	updateColumns := []string{}
+	createdAt := class.CreatedAtMember()
+	for _, member := range class.ColumnMembers() {
+		if _, exists := conflictColumnMap[member.Name.SnakeName()]; exists {
+			continue
+		}
+		if member.AutoIncrement {
+			continue
+		}
+		if member.LockVersion {
+			continue
+		}
+		if member == createdAt {
+			continue
+		}
+		updateColumns = append(updateColumns, member.Name.SnakeName())
+	}
+	versionColumn := ""
+	if member := class.LockVersionMember(); member != nil {
+		versionColumn = member.Name.SnakeName()
+	}
+	return dialect.UpsertClause(class.Name.PluralSnakeName(), conflictColumns, updateColumns, autoIncrementColumn, versionColumn)
+}
+
+func (g *Generator) newUpsertMethodGenerator(class *types.Class, conflictMembers types.Members) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
+	decl, err := g.newUpsertDeclare(class)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to declaration for upsert: %w", err)
+	}
+	param := g.newUpsertParam(class, conflictMembers)
+	members, returningMember := g.insertMembers(dialect, class)
+	placeholders := []string{}
+	columns := []string{}
+	args := []Code{}
+	scanValues := []Code{}
+	for _, member := range members {
+		placeholders = append(placeholders, dialect.Placeholder(len(placeholders)+1))
+		columns = append(columns, dialect.Quote(string(member.Name)))
+		args = append(args, dialect.ValueCode(member.Type, param.Args.Value().Dot(member.Name.CamelName())))
+	}
+	autoIncrementMember := class.AutoIncrementMember()
+	autoIncrementColumn := ""
+	if autoIncrementMember != nil && dialect.ReturnsLastInsertIDByUpsert() {
+		autoIncrementColumn = autoIncrementMember.Name.SnakeName()
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s) %s`,
+		dialect.Quote(class.Name.PluralSnakeName()),
+		strings.Join(columns, ", "),
+		strings.Join(placeholders, ", "),
+		g.upsertClause(dialect, class, conflictMembers, autoIncrementColumn),
+	)
+	if returningMember != nil {
+		query += fmt.Sprintf(" RETURNING %s", dialect.Quote(returningMember.Name.SnakeName()))
+		scanValues = append(scanValues, Op("&").Add(param.Args.Value()).Dot(returningMember.Name.CamelName()))
+	}
+	param.SQL = &types.SQL{
+		Query:      query,
+		Args:       args,
+		ScanValues: scanValues,
+	}
+	if autoIncrementMember != nil && returningMember == nil && autoIncrementColumn == "" {
+		conditions := []string{}
+		findArgs := []Code{}
+		for idx, member := range conflictMembers {
+			conditions = append(conditions, g.condition(dialect, member, idx+1))
+			findArgs = append(findArgs, dialect.ValueCode(member.Type, param.Args.Value().Dot(member.Name.CamelName())))
+		}
+		param.FindSQL = &types.SQL{
+			Query: fmt.Sprintf("SELECT %s FROM %s WHERE %s",
+				dialect.Quote(autoIncrementMember.Name.SnakeName()),
+				dialect.Quote(class.Name.PluralSnakeName()),
+				strings.Join(conditions, " AND "),
+			),
+			Args:       findArgs,
+			ScanValues: []Code{Op("&").Add(param.Args.Value()).Dot(autoIncrementMember.Name.CamelName())},
+		}
+	}
+	return &MethodGenerator{
+		decl:  decl,
+		hooks: g.getHookCodes(class, "upsert", param),
+	}, nil
+}
+
+func (g *Generator) newUpsertMultiMethodGenerator(class *types.Class, conflictMembers types.Members) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
+	decl, err := g.newUpsertMultiDeclare(class)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to declaration for upsert-multi: %w", err)
+	}
+	param := g.newUpsertMultiParam(class, conflictMembers)
+	param.SQL = g.insertMultiSQL(dialect, class, param.Args.Value, g.upsertClause(dialect, class, conflictMembers, ""))
+	return &MethodGenerator{
+		decl:  decl,
+		hooks: g.getHookCodes(class, "upsert-multi", param),
+	}, nil
+}
+
 func (g *Generator) newUpdateMethodGenerator(class *types.Class) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newUpdateDeclare(class)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for update: %w", err)
 	}
 	param := g.newUpdateParam(class)
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	lockVersionMember, err := g.lockVersionMember(class)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid lock_version of %s: %w", class.Name, err)
+	}
+	param.LockVersionMember = lockVersionMember
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
+	primaryKeys := g.primaryKeys(class)
+	if len(primaryKeys) == 0 {
+		return nil, xerrors.Errorf("cannot update %s without primary key or id member", class.Name)
+	}
 	columns := []string{}
+	args := []Code{}
 	for _, member := range class.Members {
 		if member.Relation != nil {
 			continue
@@ -935,13 +1464,41 @@ func (g *Generator) newUpdateMethodGenerator(class *types.Class) (*MethodGenerat
 		if member.Extend {
 			continue
 		}
-		if member.Name.SnakeName() == "id" {
+		if primaryKeys.Contains(member) {
 			continue
 		}
-		columns = append(columns, fmt.Sprintf("`%s` = ?", member.Name.SnakeName()))
+		if member == lockVersionMember {
+			continue
+		}
+		columns = append(columns, g.condition(dialect, member, len(columns)+1))
+		args = append(args, dialect.ValueCode(member.Type, param.Args.Value().Dot(member.Name.CamelName())))
+	}
+	placeholderNum := len(columns)
+	if lockVersionMember != nil {
+		// version is incremented by database not to depend on the value of entity
+		escapedColumn := dialect.Quote(lockVersionMember.Name.SnakeName())
+		columns = append(columns, fmt.Sprintf("%s = %s + 1", escapedColumn, escapedColumn))
+	}
+	conditions := []string{}
+	for _, member := range primaryKeys {
+		conditions = append(conditions, g.condition(dialect, member, placeholderNum+len(conditions)+1))
+		args = append(args, param.Args.Value().Dot(member.Name.CamelName()))
+	}
+	if lockVersionMember != nil {
+		conditions = append(conditions, g.condition(dialect, lockVersionMember, placeholderNum+len(conditions)+1))
+		args = append(args, param.Args.Value().Dot(lockVersionMember.Name.CamelName()))
 	}
 	param.SQL = &types.SQL{
-		Query: fmt.Sprintf(`UPDATE %s SET %s WHERE id = ?`, escapedTableName, strings.Join(columns, ", ")),
+		Query: fmt.Sprintf(`UPDATE %s SET %s WHERE %s`,
+			escapedTableName,
+			strings.Join(columns, ", "),
+			strings.Join(conditions, " AND "),
+		),
+		Args: args,
+	}
+	if len(columns) == 0 {
+		// all columns are part of primary key, so there is nothing to update
+		param.SQL = &types.SQL{}
 	}
 	return &MethodGenerator{
 		decl:  decl,
@@ -949,15 +1506,50 @@ func (g *Generator) newUpdateMethodGenerator(class *types.Class) (*MethodGenerat
 	}, nil
 }
 
+// lockVersionMember returns member with lock_version after checking it is able to be used as version
+func (g *Generator) lockVersionMember(class *types.Class) (*types.Member, error) {
+	member := class.LockVersionMember()
+	if member == nil {
+		return nil, nil
+	}
+	if !DataStoreByName(class.DataStore).SupportsOptimisticLock() {
+		return nil, xerrors.Errorf("datastore %s doesn't support lock_version", class.DataStore)
+	}
+	for _, m := range class.Members {
+		if m != member && m.LockVersion {
+			return nil, xerrors.Errorf("lock_version is specified for multiple members %s and %s", member.Name, m.Name)
+		}
+	}
+	if g.primaryKeys(class).Contains(member) {
+		return nil, xerrors.Errorf("primary key %s cannot be used as lock_version", member.Name)
+	}
+	if member.Nullable || member.Type.IsPointer || !(member.Type.Type.IsInt() || member.Type.Type.IsUint()) {
+		return nil, xerrors.Errorf("type of lock_version %s must be integer", member.Name)
+	}
+	return member, nil
+}
+
 func (g *Generator) newDeleteMethodGenerator(class *types.Class) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newDeleteDeclare(class)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for delete: %w", err)
 	}
 	param := g.newDeleteParam(class)
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
+	primaryKeys := g.primaryKeys(class)
+	if len(primaryKeys) == 0 {
+		return nil, xerrors.Errorf("cannot delete %s without primary key or id member", class.Name)
+	}
+	conditions := []string{}
+	args := []Code{}
+	for _, member := range primaryKeys {
+		conditions = append(conditions, g.condition(dialect, member, len(conditions)+1))
+		args = append(args, param.Args.Value().Dot(member.Name.CamelName()))
+	}
 	param.SQL = &types.SQL{
-		Query: fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, escapedTableName),
+		Query: fmt.Sprintf(`DELETE FROM %s WHERE %s`, escapedTableName, strings.Join(conditions, " AND ")),
+		Args:  args,
 	}
 	return &MethodGenerator{
 		decl:  decl,
@@ -965,13 +1557,13 @@ func (g *Generator) newDeleteMethodGenerator(class *types.Class) (*MethodGenerat
 	}, nil
 }
 
-func (g *Generator) newFindAllMethodGenerator(class *types.Class) (*MethodGenerator, error) {
-	decl, err := g.newFindAllDeclare(class)
+func (g *Generator) newFindAllMethodGenerator(class *types.Class, param *types.FindParam) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
+	decl, err := g.newFindAllDeclare(class, param)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for findAll: %w", err)
 	}
-	param := g.newFindParam(class)
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	columns := []string{}
 	scanValues := []Code{}
 	for _, member := range class.Members {
@@ -981,8 +1573,8 @@ func (g *Generator) newFindAllMethodGenerator(class *types.Class) (*MethodGenera
 		if member.Extend {
 			continue
 		}
-		columns = append(columns, fmt.Sprintf("`%s`", member.Name))
-		scanValues = append(scanValues, Op("&").Id("value").Dot(member.Name.CamelName()))
+		columns = append(columns, dialect.Quote(string(member.Name)))
+		scanValues = append(scanValues, g.scanValue(dialect, member))
 	}
 	param.SQL = &types.SQL{
 		Query:      fmt.Sprintf(`SELECT %s FROM %s`, strings.Join(columns, ", "), escapedTableName),
@@ -995,12 +1587,13 @@ func (g *Generator) newFindAllMethodGenerator(class *types.Class) (*MethodGenera
 }
 
 func (g *Generator) newCountMethodGenerator(class *types.Class) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newCountDeclare(class)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for count: %w", err)
 	}
 	param := g.newCountParam(class)
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	param.SQL = &types.SQL{
 		Query: fmt.Sprintf(`COUNT(*) FROM %s`, escapedTableName),
 	}
@@ -1010,8 +1603,50 @@ func (g *Generator) newCountMethodGenerator(class *types.Class) (*MethodGenerato
 	}, nil
 }
 
+func (g *Generator) newQueryMethodGenerator(class *types.Class, query *types.Query) (*MethodGenerator, error) {
+	if err := query.Validate(); err != nil {
+		return nil, xerrors.Errorf("invalid query of %s: %w", class.Name, err)
+	}
+	dialect := g.dialectByClass(class)
+	param := g.newQueryParam(class, query)
+	decl, err := g.newQueryDeclare(class, param)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to declaration for query: %w", err)
+	}
+	columns := []string{}
+	scanValues := []Code{}
+	for _, member := range class.ColumnMembers() {
+		columns = append(columns, dialect.Quote(string(member.Name)))
+		scanValues = append(scanValues, Line().Add(g.scanValue(dialect, member)))
+	}
+	scanValues = append(scanValues, Line())
+	boundQuery, params, err := query.Bind(dialect, columns)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot bind parameters of query: %w", err)
+	}
+	// arguments are passed in order of appearance of named parameters in query
+	argMap := map[*types.QueryParameter]Code{}
+	for idx, p := range query.Params {
+		argMap[p] = dialect.ValueCode(p.Type, Id(fmt.Sprintf("a%d", idx)))
+	}
+	args := []Code{}
+	for _, p := range params {
+		args = append(args, argMap[p])
+	}
+	param.SQL = &types.SQL{
+		Query:      boundQuery,
+		Args:       args,
+		ScanValues: scanValues,
+	}
+	return &MethodGenerator{
+		decl:  decl,
+		hooks: g.getHookCodes(class, "query", param),
+	}, nil
+}
+
 func (g *Generator) createSQLForFindBy(class *types.Class, param *types.FindParam) *types.SQL {
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	dialect := g.dialectByClass(class)
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	columns := []string{}
 	scanValues := []Code{}
 	for _, member := range class.Members {
@@ -1021,21 +1656,35 @@ func (g *Generator) createSQLForFindBy(class *types.Class, param *types.FindPara
 		if member.Extend {
 			continue
 		}
-		columns = append(columns, fmt.Sprintf("`%s`", member.Name))
-		scanValues = append(scanValues, Line().Op("&").Id("value").Dot(member.Name.CamelName()))
+		columns = append(columns, dialect.Quote(string(member.Name)))
+		scanValues = append(scanValues, Line().Add(g.scanValue(dialect, member)))
 	}
 	scanValues = append(scanValues, Line())
 	conditions := []string{}
 	argNames := []Code{}
-	for idx, member := range param.Args.Members {
-		conditions = append(conditions, fmt.Sprintf("`%s` = ?", member.Name.SnakeName()))
+	members := param.Args.Members
+	if param.Args.Range != "" {
+		members = members[:len(members)-1]
+	}
+	for idx, member := range members {
+		conditions = append(conditions, g.condition(dialect, member, idx+1))
 		argNames = append(argNames, Id(fmt.Sprintf("a%d", idx)))
 	}
+	if param.Args.Range != "" {
+		lastMember := param.Args.Members[len(param.Args.Members)-1]
+		placeholders := []string{}
+		for i := 0; i < param.Args.Range.ArgNum(); i++ {
+			argNames = append(argNames, Id(fmt.Sprintf("a%d", len(argNames))))
+			placeholders = append(placeholders, dialect.Placeholder(len(argNames)))
+		}
+		conditions = append(conditions, param.Args.Range.Condition(dialect.Quote(lastMember.Name.SnakeName()), placeholders...))
+	}
 	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`,
 		strings.Join(columns, ", "),
 		escapedTableName,
 		strings.Join(conditions, " AND "),
 	)
+	query = g.appendLockClause(dialect, param, query)
 	return &types.SQL{
 		Query:      query,
 		Args:       argNames,
@@ -1043,6 +1692,14 @@ func (g *Generator) createSQLForFindBy(class *types.Class, param *types.FindPara
 	}
 }
 
+// appendLockClause appends clause to take row lock if method is locking read
+func (g *Generator) appendLockClause(dialect types.Dialect, param *types.FindParam, query string) string {
+	if param.Args.Lock == "" {
+		return query
+	}
+	return fmt.Sprintf("%s %s", query, dialect.LockClause(param.Args.Lock))
+}
+
 func (g *Generator) newFindByMethodGenerator(class *types.Class, param *types.FindParam) (*MethodGenerator, error) {
 	decl, err := g.newFindByDeclare(class, param)
 	if err != nil {
@@ -1068,11 +1725,12 @@ func (g *Generator) newFindBySliceMethodGenerator(class *types.Class, param *typ
 }
 
 func (g *Generator) newFindByPluralMethodGenerator(class *types.Class, param *types.FindParam) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newFindByPluralDeclare(class, param)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for findByPlural: %w", err)
 	}
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	columns := []string{}
 	scanValues := []Code{}
 	for _, member := range class.Members {
@@ -1082,15 +1740,16 @@ func (g *Generator) newFindByPluralMethodGenerator(class *types.Class, param *ty
 		if member.Extend {
 			continue
 		}
-		columns = append(columns, fmt.Sprintf("`%s`", member.Name))
-		scanValues = append(scanValues, Line().Op("&").Id("value").Dot(member.Name.CamelName()))
+		columns = append(columns, dialect.Quote(string(member.Name)))
+		scanValues = append(scanValues, Line().Add(g.scanValue(dialect, member)))
 	}
 	scanValues = append(scanValues, Line())
 	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s IN (%%s)`,
 		strings.Join(columns, ", "),
 		escapedTableName,
-		fmt.Sprintf("`%s`", param.Args.Members[0].Name.SnakeName()),
+		dialect.Quote(param.Args.Members[0].Name.SnakeName()),
 	)
+	query = g.appendLockClause(dialect, param, query)
 	param.SQL = &types.SQL{
 		Query:      query,
 		ScanValues: scanValues,
@@ -1102,11 +1761,12 @@ func (g *Generator) newFindByPluralMethodGenerator(class *types.Class, param *ty
 }
 
 func (g *Generator) newUpdateByMethodGenerator(class *types.Class, param *types.UpdateParam) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newUpdateByDeclare(class, param)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for updateBy: %w", err)
 	}
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	columns := []string{}
 	for _, member := range param.Args.Members {
 		if member.Relation != nil {
@@ -1115,7 +1775,7 @@ func (g *Generator) newUpdateByMethodGenerator(class *types.Class, param *types.
 		if member.Extend {
 			continue
 		}
-		columns = append(columns, fmt.Sprintf("`%s` = ?", member.Name.SnakeName()))
+		columns = append(columns, g.condition(dialect, member, len(columns)+1))
 	}
 	query := fmt.Sprintf(`UPDATE %s SET %%s WHERE %s`,
 		escapedTableName,
@@ -1131,15 +1791,16 @@ func (g *Generator) newUpdateByMethodGenerator(class *types.Class, param *types.
 }
 
 func (g *Generator) newUpdateByPluralMethodGenerator(class *types.Class, param *types.UpdateParam) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newUpdateByPluralDeclare(class, param)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for updateByPlural: %w", err)
 	}
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	member := param.Args.Members[0]
 	query := fmt.Sprintf(`UPDATE %s SET %%s WHERE %s`,
 		escapedTableName,
-		fmt.Sprintf("`%s` IN (%%s)", member.Name.SnakeName()),
+		fmt.Sprintf("%s IN (%%s)", dialect.Quote(member.Name.SnakeName())),
 	)
 	param.SQL = &types.SQL{
 		Query: query,
@@ -1150,16 +1811,44 @@ func (g *Generator) newUpdateByPluralMethodGenerator(class *types.Class, param *
 	}, nil
 }
 
+// newUpdateColumnsMethodGenerator returns generator of helper method to build SET clause from *entity.XXXUpdate.
+// it is unexported and isn't declared in interface because it is called only by UpdateBy and UpdateByPlural.
+func (g *Generator) newUpdateColumnsMethodGenerator(class *types.Class, codes []Code) (*MethodGenerator, error) {
+	decl := &types.MethodDeclare{
+		Class:             class,
+		ReceiverName:      g.receiverName,
+		ReceiverClassName: fmt.Sprintf("%sImpl", class.Name.CamelName()),
+		ImportList:        g.importList,
+		MethodName:        UpdateColumnsMethodName,
+		Args: types.ValueDeclares{
+			g.updateArg(class),
+			{
+				Name: "args",
+				Type: types.TypeDeclareWithName("[]interface{}"),
+			},
+		},
+		Return: types.ValueDeclares{
+			{Type: types.TypeDeclareWithName("[]string")},
+			{Type: types.TypeDeclareWithName("[]interface{}")},
+		},
+	}
+	return &MethodGenerator{
+		decl:  decl,
+		hooks: codes,
+	}, nil
+}
+
 func (g *Generator) newDeleteByMethodGenerator(class *types.Class, param *types.DeleteParam) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newDeleteByDeclare(class, param)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for deleteBy: %w", err)
 	}
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	columns := []string{}
 	args := []Code{}
 	for idx, member := range param.Args.Members {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", member.Name.SnakeName()))
+		columns = append(columns, g.condition(dialect, member, idx+1))
 		args = append(args, Id(fmt.Sprintf("a%d", idx)))
 	}
 	query := fmt.Sprintf(`DELETE FROM %s WHERE %s`,
@@ -1177,15 +1866,16 @@ func (g *Generator) newDeleteByMethodGenerator(class *types.Class, param *types.
 }
 
 func (g *Generator) newDeleteByPluralMethodGenerator(class *types.Class, param *types.DeleteParam) (*MethodGenerator, error) {
+	dialect := g.dialectByClass(class)
 	decl, err := g.newDeleteByPluralDeclare(class, param)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to declaration for deleteByPlural: %w", err)
 	}
-	escapedTableName := fmt.Sprintf("`%s`", class.Name.PluralSnakeName())
+	escapedTableName := dialect.Quote(class.Name.PluralSnakeName())
 	member := param.Args.Members[0]
 	query := fmt.Sprintf(`DELETE FROM %s WHERE %s`,
 		escapedTableName,
-		fmt.Sprintf("`%s` IN (%%s)", member.Name.SnakeName()),
+		fmt.Sprintf("%s IN (%%s)", dialect.Quote(member.Name.SnakeName())),
 	)
 	param.SQL = &types.SQL{Query: query}
 	return &MethodGenerator{
@@ -1194,9 +1884,51 @@ func (g *Generator) newDeleteByPluralMethodGenerator(class *types.Class, param *
 	}, nil
 }
 
-func (g *Generator) newFindByMethodGeneratorsFromPrimaryKey(class *types.Class, primaryKey *types.Member) ([]*MethodGenerator, error) {
+func (g *Generator) newFindBySliceMethodGenerators(class *types.Class, key types.Members) ([]*MethodGenerator, error) {
+	generators := []*MethodGenerator{}
+	for _, p := range g.newFindParamsForMultipleRecords(class, key) {
+		generator, err := g.newFindBySliceMethodGenerator(class, p)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerator: %w", err)
+		}
+		generators = append(generators, generator)
+	}
+	return generators, nil
+}
+
+func (g *Generator) newFindByPluralMethodGenerators(class *types.Class, key types.Members) ([]*MethodGenerator, error) {
+	generators := []*MethodGenerator{}
+	for _, p := range g.newFindParamsForMultipleRecords(class, key) {
+		generator, err := g.newFindByPluralMethodGenerator(class, p)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerator: %w", err)
+		}
+		generators = append(generators, generator)
+	}
+	return generators, nil
+}
+
+func (g *Generator) newFindByMethodGeneratorsFromRangeKey(class *types.Class, rangeKey *types.RangeKey) ([]*MethodGenerator, error) {
+	for _, member := range rangeKey.Members {
+		if member == nil {
+			return nil, xerrors.Errorf("unknown column is included in key declared range %s", rangeKey.Operator)
+		}
+	}
+	generators := []*MethodGenerator{}
+	for _, p := range g.newFindParamsForMultipleRecords(class, rangeKey.Members) {
+		p.Args.Range = rangeKey.Operator
+		generator, err := g.newFindBySliceMethodGenerator(class, p)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerator: %w", err)
+		}
+		generators = append(generators, generator)
+	}
+	return generators, nil
+}
+
+func (g *Generator) newFindByMethodGeneratorsFromPrimaryKey(class *types.Class, primaryKey types.Members) ([]*MethodGenerator, error) {
 	p := g.newFindParam(class)
-	p.Args.Members = append(p.Args.Members, primaryKey)
+	p.Args.Members = append(p.Args.Members, primaryKey...)
 	p.IsSingleReturnValue = true
 	findByGen, err := g.newFindByMethodGenerator(class, p)
 	if err != nil {
@@ -1205,13 +1937,40 @@ func (g *Generator) newFindByMethodGeneratorsFromPrimaryKey(class *types.Class,
 	if len(p.Args.Members) != 1 {
 		return []*MethodGenerator{findByGen}, nil
 	}
-	p = g.newFindParam(class)
-	p.Args.Members = append(p.Args.Members, primaryKey)
-	findByPluralGen, err := g.newFindByPluralMethodGenerator(class, p)
+	findByPluralGens, err := g.newFindByPluralMethodGenerators(class, primaryKey)
 	if err != nil {
-		return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerator: %w", err)
+		return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerators: %w", err)
 	}
-	return []*MethodGenerator{findByGen, findByPluralGen}, nil
+	return append([]*MethodGenerator{findByGen}, findByPluralGens...), nil
+}
+
+// newLockingReadMethodGenerators returns methods to find records by key with row lock for each lock mode.
+// the plural variant ( e.g. FindByIDsForUpdate ) is generated only for key which has a member.
+func (g *Generator) newLockingReadMethodGenerators(class *types.Class, key types.Members) ([]*MethodGenerator, error) {
+	generators := []*MethodGenerator{}
+	for _, lock := range types.LockModes {
+		p := g.newFindParam(class)
+		p.Args.Members = append(p.Args.Members, key...)
+		p.Args.Lock = lock
+		p.IsSingleReturnValue = true
+		findByGen, err := g.newFindByMethodGenerator(class, p)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create FindByMethodGenerator: %w", err)
+		}
+		generators = append(generators, findByGen)
+		if len(key) != 1 {
+			continue
+		}
+		p = g.newFindParam(class)
+		p.Args.Members = append(p.Args.Members, key...)
+		p.Args.Lock = lock
+		findByPluralGen, err := g.newFindByPluralMethodGenerator(class, p)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerator: %w", err)
+		}
+		generators = append(generators, findByPluralGen)
+	}
+	return generators, nil
 }
 
 func (g *Generator) newFindByMethodGeneratorsFromUniqueKey(class *types.Class, uniqueKey types.Members) ([]*MethodGenerator, error) {
@@ -1224,34 +1983,28 @@ func (g *Generator) newFindByMethodGeneratorsFromUniqueKey(class *types.Class, u
 	}
 	generators := []*MethodGenerator{findByGen}
 	if len(p.Args.Members) == 1 {
-		p = g.newFindParam(class)
-		p.Args.Members = append(p.Args.Members, uniqueKey...)
-		findByPluralGen, err := g.newFindByPluralMethodGenerator(class, p)
+		findByPluralGens, err := g.newFindByPluralMethodGenerators(class, uniqueKey)
 		if err != nil {
-			return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerator: %w", err)
+			return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerators: %w", err)
 		}
-		generators = append(generators, findByPluralGen)
+		generators = append(generators, findByPluralGens...)
 	}
 	if len(uniqueKey) < 2 {
 		return generators, nil
 	}
 	uniqueKey = uniqueKey[:len(uniqueKey)-1]
 	for i := len(uniqueKey); i > 0; i-- {
-		p := g.newFindParam(class)
-		p.Args.Members = append(p.Args.Members, uniqueKey...)
-		generator, err := g.newFindBySliceMethodGenerator(class, p)
+		findBySliceGens, err := g.newFindBySliceMethodGenerators(class, uniqueKey)
 		if err != nil {
-			return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerator: %w", err)
+			return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerators: %w", err)
 		}
-		generators = append(generators, generator)
+		generators = append(generators, findBySliceGens...)
 		if len(uniqueKey) == 1 {
-			p = g.newFindParam(class)
-			p.Args.Members = append(p.Args.Members, uniqueKey...)
-			findByPluralGen, err := g.newFindByPluralMethodGenerator(class, p)
+			findByPluralGens, err := g.newFindByPluralMethodGenerators(class, uniqueKey)
 			if err != nil {
-				return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerator: %w", err)
+				return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerators: %w", err)
 			}
-			generators = append(generators, findByPluralGen)
+			generators = append(generators, findByPluralGens...)
 		}
 		uniqueKey = uniqueKey[:len(uniqueKey)-1]
 	}
@@ -1259,51 +2012,42 @@ func (g *Generator) newFindByMethodGeneratorsFromUniqueKey(class *types.Class, u
 }
 
 func (g *Generator) newFindByMethodGeneratorsFromKey(class *types.Class, key types.Members) ([]*MethodGenerator, error) {
-	p := g.newFindParam(class)
-	p.Args.Members = append(p.Args.Members, key...)
-	findByGen, err := g.newFindBySliceMethodGenerator(class, p)
+	generators, err := g.newFindBySliceMethodGenerators(class, key)
 	if err != nil {
-		return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerator: %w", err)
+		return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerators: %w", err)
 	}
-	generators := []*MethodGenerator{findByGen}
-	if len(p.Args.Members) == 1 {
-		p = g.newFindParam(class)
-		p.Args.Members = append(p.Args.Members, key...)
-		findByPluralGen, err := g.newFindByPluralMethodGenerator(class, p)
+	if len(key) == 1 {
+		findByPluralGens, err := g.newFindByPluralMethodGenerators(class, key)
 		if err != nil {
-			return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerator: %w", err)
+			return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerators: %w", err)
 		}
-		generators = append(generators, findByPluralGen)
+		generators = append(generators, findByPluralGens...)
 	}
 	if len(key) < 2 {
 		return generators, nil
 	}
 	key = key[:len(key)-1]
 	for i := len(key); i > 0; i-- {
-		p := g.newFindParam(class)
-		p.Args.Members = append(p.Args.Members, key...)
-		generator, err := g.newFindBySliceMethodGenerator(class, p)
+		findBySliceGens, err := g.newFindBySliceMethodGenerators(class, key)
 		if err != nil {
-			return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerator: %w", err)
+			return nil, xerrors.Errorf("cannot create FindBySliceMethodGenerators: %w", err)
 		}
-		generators = append(generators, generator)
+		generators = append(generators, findBySliceGens...)
 		if len(key) == 1 {
-			p = g.newFindParam(class)
-			p.Args.Members = append(p.Args.Members, key...)
-			findByPluralGen, err := g.newFindByPluralMethodGenerator(class, p)
+			findByPluralGens, err := g.newFindByPluralMethodGenerators(class, key)
 			if err != nil {
-				return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerator: %w", err)
+				return nil, xerrors.Errorf("cannot create FindByPluralMethodGenerators: %w", err)
 			}
-			generators = append(generators, findByPluralGen)
+			generators = append(generators, findByPluralGens...)
 		}
 		key = key[:len(key)-1]
 	}
 	return generators, nil
 }
 
-func (g *Generator) newUpdateByMethodGeneratorsFromPrimaryKey(class *types.Class, primaryKey *types.Member) ([]*MethodGenerator, error) {
+func (g *Generator) newUpdateByMethodGeneratorsFromPrimaryKey(class *types.Class, primaryKey types.Members) ([]*MethodGenerator, error) {
 	p := g.newUpdateParam(class)
-	p.Args.Members = append(p.Args.Members, primaryKey)
+	p.Args.Members = append(p.Args.Members, primaryKey...)
 	updateByGen, err := g.newUpdateByMethodGenerator(class, p)
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create UpdateByMethodGenerator: %w", err)
@@ -1312,7 +2056,7 @@ func (g *Generator) newUpdateByMethodGeneratorsFromPrimaryKey(class *types.Class
 		return []*MethodGenerator{updateByGen}, nil
 	}
 	p = g.newUpdateParam(class)
-	p.Args.Members = append(p.Args.Members, primaryKey)
+	p.Args.Members = append(p.Args.Members, primaryKey...)
 	updateByPluralGen, err := g.newUpdateByPluralMethodGenerator(class, p)
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create UpdateByPluralMethodGenerator: %w", err)
@@ -1358,9 +2102,9 @@ func (g *Generator) newUpdateByMethodGeneratorsFromKey(class *types.Class, key t
 	return []*MethodGenerator{updateByGen, updateByPluralGen}, nil
 }
 
-func (g *Generator) newDeleteByMethodGeneratorsFromPrimaryKey(class *types.Class, primaryKey *types.Member) ([]*MethodGenerator, error) {
+func (g *Generator) newDeleteByMethodGeneratorsFromPrimaryKey(class *types.Class, primaryKey types.Members) ([]*MethodGenerator, error) {
 	p := g.newDeleteParam(class)
-	p.Args.Members = append(p.Args.Members, primaryKey)
+	p.Args.Members = append(p.Args.Members, primaryKey...)
 	deleteByGen, err := g.newDeleteByMethodGenerator(class, p)
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create DeleteByMethodGenerator: %w", err)
@@ -1369,7 +2113,7 @@ func (g *Generator) newDeleteByMethodGeneratorsFromPrimaryKey(class *types.Class
 		return []*MethodGenerator{deleteByGen}, nil
 	}
 	p = g.newDeleteParam(class)
-	p.Args.Members = append(p.Args.Members, primaryKey)
+	p.Args.Members = append(p.Args.Members, primaryKey...)
 	deleteByPluralGen, err := g.newDeleteByPluralMethodGenerator(class, p)
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create DeleteByPluralMethodGenerator: %w", err)
@@ -1424,6 +2168,24 @@ func (g *Generator) newMethodGenerators(class *types.Class) (MethodGenerators, e
 		}
 		gens = append(gens, gen)
 	}
+	if !class.ReadOnly {
+		gen, err := g.newCreateMultiMethodGenerator(class)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create CreateMultiMethodGenerator: %w", err)
+		}
+		gens = append(gens, gen)
+	}
+	if conflictMembers := g.upsertKey(class); !class.ReadOnly && len(conflictMembers) > 0 {
+		upsertGen, err := g.newUpsertMethodGenerator(class, conflictMembers)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create UpsertMethodGenerator: %w", err)
+		}
+		upsertMultiGen, err := g.newUpsertMultiMethodGenerator(class, conflictMembers)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create UpsertMultiMethodGenerator: %w", err)
+		}
+		gens = append(gens, upsertGen, upsertMultiGen)
+	}
 	if !class.ReadOnly {
 		gen, err := g.newUpdateMethodGenerator(class)
 		if err != nil {
@@ -1438,8 +2200,8 @@ func (g *Generator) newMethodGenerators(class *types.Class) (MethodGenerators, e
 		}
 		gens = append(gens, gen)
 	}
-	{
-		gen, err := g.newFindAllMethodGenerator(class)
+	for _, param := range g.newFindParamsForMultipleRecords(class, types.Members{}) {
+		gen, err := g.newFindAllMethodGenerator(class, param)
 		if err != nil {
 			return nil, xerrors.Errorf("cannot create FindAllMethodGenerator: %w", err)
 		}
@@ -1452,8 +2214,8 @@ func (g *Generator) newMethodGenerators(class *types.Class) (MethodGenerators, e
 		}
 		gens = append(gens, gen)
 	}
-	primaryKey := class.PrimaryKey()
-	if primaryKey != nil {
+	primaryKey := class.PrimaryKeys()
+	if len(primaryKey) > 0 {
 		findByGens, err := g.newFindByMethodGeneratorsFromPrimaryKey(class, primaryKey)
 		if err != nil {
 			return nil, xerrors.Errorf("cannot create FindByMethodGenerators from primary key: %w", err)
@@ -1510,6 +2272,61 @@ func (g *Generator) newMethodGenerators(class *types.Class) (MethodGenerators, e
 			gens = append(gens, deleteByGens...)
 		}
 	}
+	for _, rangeKey := range class.RangeKeys() {
+		findByGens, err := g.newFindByMethodGeneratorsFromRangeKey(class, rangeKey)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create FindByMethodGenerators from range key: %w", err)
+		}
+		gens = append(gens, findByGens...)
+	}
+	if !class.ReadOnly && !g.cfg.UseUpdateMap() && gens.hasUpdateBy() {
+		if codes := DataStoreByName(class.DataStore).UpdateColumns(g.newUpdateParam(class)); len(codes) > 0 {
+			gen, err := g.newUpdateColumnsMethodGenerator(class, codes)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot create UpdateColumnsMethodGenerator: %w", err)
+			}
+			gens = append(gens, gen)
+		}
+	}
+	if class.LockingRead {
+		if !DataStoreByName(class.DataStore).SupportsLockingRead() {
+			return nil, xerrors.Errorf("datastore %s of %s doesn't support locking read", class.DataStore, class.Name)
+		}
+		if dialect := g.dialectByClass(class); !dialect.SupportsLockingRead() {
+			return nil, xerrors.Errorf("dialect %s of %s doesn't support locking read", dialect, class.Name)
+		}
+		keys := class.UniqueKeys()
+		if len(primaryKey) > 0 {
+			keys = append([]types.Members{primaryKey}, keys...)
+		}
+		for _, key := range keys {
+			lockingReadGens, err := g.newLockingReadMethodGenerators(class, key)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot create locking read MethodGenerators: %w", err)
+			}
+			gens = append(gens, lockingReadGens...)
+		}
+	}
+	methodNameMap := map[string]struct{}{}
+	for _, gen := range gens {
+		methodNameMap[gen.decl.MethodName] = struct{}{}
+	}
+	if len(class.Queries) > 0 {
+		if !DataStoreByName(class.DataStore).SupportsQuery() {
+			return nil, xerrors.Errorf("datastore %s of %s doesn't support queries", class.DataStore, class.Name)
+		}
+	}
+	for _, query := range class.Queries {
+		gen, err := g.newQueryMethodGenerator(class, query)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create QueryMethodGenerator: %w", err)
+		}
+		if _, exists := methodNameMap[gen.decl.MethodName]; exists {
+			return nil, xerrors.Errorf("query %s of %s conflicts with other method", query.Name, class.Name)
+		}
+		methodNameMap[gen.decl.MethodName] = struct{}{}
+		gens = append(gens, gen)
+	}
 	return gens, nil
 }
 
@@ -1552,8 +2369,7 @@ func (g *Generator) generateConstructor(class *types.Class, decl *types.Construc
 }
 
 func (g *Generator) existsFile(class *types.Class, path string) bool {
-	_, err := os.Stat(filepath.Join(path, fmt.Sprintf("%s.go", class.Name.SnakeName())))
-	return err == nil
+	return g.cfg.OutputWriter().Exists(filepath.Join(path, fmt.Sprintf("%s.go", class.Name.SnakeName())))
 }
 
 type Decl struct {
@@ -1735,10 +2551,10 @@ func (g *Generator) parseFile(class *types.Class, path string) (*PackageDecl, er
 		Structs:    []*Decl{},
 	}
 	src := filepath.Join(path, fmt.Sprintf("%s.go", class.Name.SnakeName()))
-	if _, err := os.Stat(src); err != nil {
+	if !g.cfg.OutputWriter().Exists(src) {
 		return pkg, nil
 	}
-	bytes, err := ioutil.ReadFile(src)
+	bytes, err := g.cfg.OutputWriter().ReadFile(src)
 	if err != nil {
 		return nil, xerrors.Errorf("cannot read file %s: %w", src, err)
 	}
@@ -1866,7 +2682,9 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 		gen, exists := newGeneratedNameMap[fn.Name]
 		if exists {
 			// overwrite by new generated method interface
-			interfaceMap[fn.Name] = gen.decl
+			if token.IsExported(fn.Name) {
+				interfaceMap[fn.Name] = gen.decl
+			}
 
 			generatedFuncCodeMap[fn.Name] = gen.Generate(g.importList)
 			methodNames = append(methodNames, fn.Name)
@@ -1876,7 +2694,7 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 	}
 	funcNameMap := pkg.Funcs.FuncNameMap()
 	for name, gen := range newGeneratedNameMap {
-		if _, exists := interfaceMap[name]; !exists {
+		if _, exists := interfaceMap[name]; !exists && token.IsExported(name) {
 			interfaceMap[name] = gen.decl
 		}
 		if _, exists := funcNameMap[name]; !exists {
@@ -1937,7 +2755,7 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 }
 
 func (g *Generator) writeFile(class *types.Class, path string, source []byte) error {
-	if err := ioutil.WriteFile(
+	if err := g.cfg.OutputWriter().WriteFile(
 		filepath.Join(path, fmt.Sprintf("%s.go", class.Name.SnakeName())),
 		source, 0644,
 	); err != nil {
@@ -1946,19 +2764,77 @@ func (g *Generator) writeFile(class *types.Class, path string, source []byte) er
 	return nil
 }
 
-func (g *Generator) Generate(classes []*types.Class) error {
+// GenerateClass generates dao for class
+func (g *Generator) GenerateClass(class *types.Class) error {
 	path := g.cfg.OutputPathWithPackage(g.packageName)
-	if err := os.MkdirAll(path, 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(path); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", path, err)
 	}
+	source, err := g.generate(class, path)
+	if err != nil {
+		return xerrors.Errorf("cannot generate source for %s: %w", class.Name.SnakeName(), err)
+	}
+	if err := g.writeFile(class, path, source); err != nil {
+		return xerrors.Errorf("cannot write file for %s: %w", class.Name.SnakeName(), err)
+	}
+	return nil
+}
+
+// GeneratePackage generates dao.go that declares errors returned by generated methods.
+// UnknownColumnError is declared if `dao.update_map` is enabled. it is returned by UpdateBy methods for key of updateMap that isn't column of table.
+// ErrStaleObject is declared if any class has member with lock_version. it is returned by Update for conflict of version.
+func (g *Generator) GeneratePackage(classes []*types.Class) error {
+	hasLockVersion := false
 	for _, class := range classes {
-		source, err := g.generate(class, path)
-		if err != nil {
-			return xerrors.Errorf("cannot generate source for %s: %w", class.Name.SnakeName(), err)
+		if class.LockVersionMember() != nil {
+			hasLockVersion = true
+			break
+		}
+	}
+	if !g.cfg.UseUpdateMap() && !hasLockVersion {
+		return nil
+	}
+	path := g.cfg.OutputPathWithPackage(g.packageName)
+	if err := g.cfg.OutputWriter().MkdirAll(path); err != nil {
+		return xerrors.Errorf("cannot create directory to %s: %w", path, err)
+	}
+	f := NewFile(g.packageName)
+	f.HeaderComment(code.GeneratedMarker)
+	if hasLockVersion {
+		f.Comment(fmt.Sprintf("%s is returned by Update when the record was updated or deleted by other transaction after it was found", StaleObjectErrorName))
+		f.Var().Id(StaleObjectErrorName).Op("=").Qual("golang.org/x/xerrors", "New").Call(Lit("stale object"))
+		f.Line()
+	}
+	if g.cfg.UseUpdateMap() {
+		f.Add(GoType().Id(UnknownColumnErrorName).Struct(
+			Id("Table").String(),
+			Id("Column").String(),
+		))
+		f.Line()
+		f.Func().Params(Id("e").Op("*").Id(UnknownColumnErrorName)).Id("Error").Params().String().Block(
+			Return(Qual("fmt", "Sprintf").Call(Lit("unknown column %q for table %s"), Id("e").Dot("Column"), Id("e").Dot("Table"))),
+		)
+	}
+	daoGoPath := filepath.Join(path, "dao.go")
+	if g.cfg.OutputWriter().Exists(daoGoPath) {
+		if err := g.cfg.OutputWriter().Remove(daoGoPath); err != nil {
+			return xerrors.Errorf("failed to remove file %s: %w", daoGoPath, err)
 		}
-		if err := g.writeFile(class, path, source); err != nil {
-			return xerrors.Errorf("cannot write file for %s: %w", class.Name.SnakeName(), err)
+	}
+	if err := g.cfg.OutputWriter().WriteFile(daoGoPath, []byte(fmt.Sprintf("%#v", f)), 0444); err != nil {
+		return xerrors.Errorf("cannot write file %s: %w", daoGoPath, err)
+	}
+	return nil
+}
+
+func (g *Generator) Generate(classes []*types.Class) error {
+	for _, class := range classes {
+		if err := g.GenerateClass(class); err != nil {
+			return xerrors.Errorf("cannot generate dao for %s: %w", class.Name.SnakeName(), err)
 		}
 	}
+	if err := g.GeneratePackage(classes); err != nil {
+		return xerrors.Errorf("cannot generate package: %w", err)
+	}
 	return nil
 }
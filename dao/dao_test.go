@@ -1,13 +1,30 @@
 package dao_test
 
 import (
+	"database/sql"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	gotypes "go/types"
+	"io/ioutil"
+	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	_ "github.com/mattn/go-sqlite3"
 	"go.knocknote.io/eevee/class"
 	"go.knocknote.io/eevee/config"
 	"go.knocknote.io/eevee/dao"
+	"go.knocknote.io/eevee/entity"
 	_ "go.knocknote.io/eevee/plugin"
+	"go.knocknote.io/eevee/types"
 )
 
 func TestGenerate(t *testing.T) {
@@ -23,3 +40,907 @@ func TestGenerate(t *testing.T) {
 		t.Fatalf("%+v", err)
 	}
 }
+
+func TestGenerateWithPostgreSQL(t *testing.T) {
+	outputPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(outputPath)
+	cfg := &config.Config{
+		ClassPath:  filepath.Join("testdata", "class"),
+		OutputPath: outputPath,
+		Dialect:    types.DialectPostgres,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "user.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		`INSERT INTO \"users\" (\"name\", \"sex\", \"age\", \"skill_id\", \"skill_rank\", \"group_id\", \"world_id\", \"field_id\") VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING \"id\"`,
+		`UPDATE \"users\" SET \"name\" = $1, \"sex\" = $2, \"age\" = $3, \"skill_id\" = $4, \"skill_rank\" = $5, \"group_id\" = $6, \"world_id\" = $7, \"field_id\" = $8 WHERE \"id\" = $9`,
+		`WHERE \"skill_id\" = $1 AND \"skill_rank\" = $2`,
+		`fmt.Sprintf("$%d", len(args))`,
+		`columns = append(columns, fmt.Sprintf("\"name\" = $%d", len(args)))`,
+		`VALUES %s RETURNING \"id\"", strings.Join(placeholders, ", "))`,
+		`placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)", len(args)+1,`,
+		`ON CONFLICT (\"name\") DO UPDATE SET \"sex\" = excluded.\"sex\", \"age\" = excluded.\"age\"`,
+		`\"field_id\" = excluded.\"field_id\" RETURNING \"id\""`,
+		`orderColumns = []string{"\"name\"", "\"id\""}`,
+		`query += fmt.Sprintf(" LIMIT $%d", len(args))`,
+		`query += " LIMIT ALL"`,
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(source))
+		}
+	}
+}
+
+func TestGenerateWithSQLite(t *testing.T) {
+	outputPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(outputPath)
+	cfg := &config.Config{
+		ClassPath:  filepath.Join("testdata", "class"),
+		OutputPath: outputPath,
+		DAO: &config.DAO{
+			Default:              "sqlite",
+			CreateMultiChunkSize: 50,
+		},
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "user.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		`INSERT INTO \"users\" (\"name\", \"sex\", \"age\", \"skill_id\", \"skill_rank\", \"group_id\", \"world_id\", \"field_id\") VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		`UPDATE \"users\" SET \"name\" = ?, \"sex\" = ?, \"age\" = ?, \"skill_id\" = ?, \"skill_rank\" = ?, \"group_id\" = ?, \"world_id\" = ?, \"field_id\" = ? WHERE \"id\" = ?`,
+		`WHERE \"skill_id\" = ? AND \"skill_rank\" = ?`,
+		`LastInsertId()`,
+		`id -= int64(len(chunk) - 1)`,
+		`if len(chunk) > 50 {`,
+		`ON CONFLICT (\"name\") DO UPDATE SET`,
+		`findQuery := "SELECT \"id\" FROM \"users\" WHERE \"name\" = ?"`,
+		`query += " LIMIT -1"`,
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(source))
+		}
+	}
+}
+
+func TestGenerateWithUpdateMap(t *testing.T) {
+	outputPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(outputPath)
+	cfg := &config.Config{
+		ClassPath:  filepath.Join("testdata", "class"),
+		OutputPath: outputPath,
+		DAO: &config.DAO{
+			UpdateMap: true,
+		},
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "group.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		"UpdateByID(context.Context, uint64, map[string]interface{}) error",
+		"UpdateByIDs(context.Context, []uint64, map[string]interface{}) error",
+		"for key, v := range updateMap {",
+		"case \"name\", \"Name\":",
+		"return &UnknownColumnError{",
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(source))
+		}
+	}
+	if strings.Contains(string(source), "entity.GroupUpdate") {
+		t.Fatalf("entity for UpdateBy should not be used:\n%s", string(source))
+	}
+	errorSource, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "dao.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !strings.Contains(string(errorSource), "type UnknownColumnError struct") {
+		t.Fatalf("cannot find UnknownColumnError in generated source:\n%s", string(errorSource))
+	}
+}
+
+func TestGenerateWithCompositePrimaryKey(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	outputPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(outputPath)
+	if err := ioutil.WriteFile(filepath.Join(classPath, "user_item.yml"), []byte(`
+name: user_item
+index:
+  primary_key:
+  - user_id
+  - item_id
+members:
+- name: user_id
+  type: uint64
+- name: item_id
+  type: uint64
+- name: count
+  type: int
+`), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cfg := &config.Config{
+		ClassPath:  classPath,
+		OutputPath: outputPath,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "user_item.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		"UPDATE `user_items` SET `count` = ? WHERE `user_id` = ? AND `item_id` = ?",
+		"DELETE FROM `user_items` WHERE `user_id` = ? AND `item_id` = ?",
+		"FindByUserIDAndItemID(",
+		"DeleteByUserIDAndItemID(",
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(source))
+		}
+	}
+}
+
+func TestGenerateWithoutPrimaryKey(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	outputPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(outputPath)
+	if err := ioutil.WriteFile(filepath.Join(classPath, "log.yml"), []byte(`
+name: log
+index:
+  keys:
+  - columns:
+    - body
+members:
+- name: id
+  type: uint64
+- name: body
+  type: string
+`), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cfg := &config.Config{
+		ClassPath:  classPath,
+		OutputPath: outputPath,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "log.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		"UPDATE `logs` SET `body` = ? WHERE `id` = ?",
+		"DELETE FROM `logs` WHERE `id` = ?",
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(source))
+		}
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(classPath, "log.yml"), []byte(`
+name: log
+index:
+  keys:
+  - columns:
+    - body
+members:
+- name: key
+  type: string
+- name: body
+  type: string
+`), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	classes, err = class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err == nil {
+		t.Fatal("expected error for class without primary key or id member")
+	}
+}
+
+func TestGenerateWithAutoIncrement(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	outputPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(outputPath)
+	for name, source := range map[string]string{
+		"account.yml": `
+name: account
+index:
+  primary_key: account_id
+members:
+- name: account_id
+  type: uint64
+  auto_increment: true
+- name: name
+  type: string
+`,
+		"session.yml": `
+name: session
+index:
+  primary_key: uuid
+members:
+- name: uuid
+  type: string
+- name: account_id
+  type: uint64
+`,
+	} {
+		if err := ioutil.WriteFile(filepath.Join(classPath, name), []byte(source), 0644); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	cfg := &config.Config{
+		ClassPath:  classPath,
+		OutputPath: outputPath,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	account, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "account.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		"value.AccountID = uint64(id)",
+		"value.AccountID = uint64(id + int64(i))",
+		"if value.AccountID == 0 {",
+		`return xerrors.New("cannot create records with and without account_id at once")`,
+		"if assigned > 0 {",
+		"func (d *AccountImpl) CreateMulti(ctx context.Context, values entity.Accounts) (e error) {",
+		"if len(chunk) > 100 {",
+	} {
+		if !strings.Contains(string(account), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(account))
+		}
+	}
+	if strings.Contains(string(account), "id -= int64(len(chunk) - 1)") {
+		t.Fatalf("LastInsertId of MySQL is ID of the first row:\n%s", string(account))
+	}
+	if !strings.Contains(string(account), "orderColumns = []string{\"`account_id`\"}") {
+		t.Fatalf("cannot find ORDER BY of primary key in generated source:\n%s", string(account))
+	}
+	if strings.Contains(string(account), "entity.AccountOrderByName") {
+		t.Fatalf("column without index should not be used for ORDER BY:\n%s", string(account))
+	}
+	if strings.Contains(string(account), "Upsert") {
+		t.Fatalf("Upsert should not be generated for auto increment primary key:\n%s", string(account))
+	}
+	session, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", "session.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if strings.Contains(string(session), "LastInsertId") {
+		t.Fatalf("LastInsertId should not be used for primary key specified by application:\n%s", string(session))
+	}
+	if !strings.Contains(string(session), "INSERT INTO `sessions` (`uuid`, `account_id`) VALUES (?, ?)") {
+		t.Fatalf("cannot find INSERT statement in generated source:\n%s", string(session))
+	}
+	if !strings.Contains(string(session), "INSERT INTO `sessions` (`uuid`, `account_id`) VALUES %s") {
+		t.Fatalf("cannot find INSERT statement in generated source:\n%s", string(session))
+	}
+	if !strings.Contains(string(session), "INSERT INTO `sessions` (`uuid`, `account_id`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `account_id` = VALUES(`account_id`)") {
+		t.Fatalf("cannot find upsert statement in generated source:\n%s", string(session))
+	}
+}
+
+func TestGenerateWithRange(t *testing.T) {
+	source := `
+name: score
+index:
+  primary_key: id
+  keys:
+  - columns:
+    - user_id
+    - created_at
+    range:
+    - between
+  - columns:
+    - point
+    range:
+    - greater_than
+members:
+- name: id
+  type: uint64
+- name: user_id
+  type: uint64
+- name: point
+  type: int
+- name: created_at
+  type:
+    import: time
+    package_name: time
+    name: Time
+`
+	for _, test := range []struct {
+		dialect  types.Dialect
+		expected []string
+	}{
+		{
+			dialect: types.DialectMySQL,
+			expected: []string{
+				"func (d *ScoreImpl) FindByUserIDAndCreatedAtBetween(ctx context.Context, a0 uint64, a1 time.Time, a2 time.Time) (r entity.Scores, e error) {",
+				"func (d *ScoreImpl) FindByUserIDAndCreatedAtBetweenWithOption(ctx context.Context, a0 uint64, a1 time.Time, a2 time.Time, opt *entity.ScoreFindOption) (r entity.Scores, e error) {",
+				"WHERE `user_id` = ? AND `created_at` BETWEEN ? AND ?",
+				"func (d *ScoreImpl) FindByPointGreaterThan(ctx context.Context, a0 int) (r entity.Scores, e error) {",
+				"WHERE `point` > ?",
+			},
+		},
+		{
+			dialect: types.DialectPostgres,
+			expected: []string{
+				`WHERE \"user_id\" = $1 AND \"created_at\" BETWEEN $2 AND $3`,
+				`WHERE \"point\" > $1`,
+			},
+		},
+	} {
+		outputPath := generateDAO(t, test.dialect, "score", source)
+		defer os.RemoveAll(outputPath)
+		score := readGenerated(t, outputPath, "score")
+		for _, expected := range test.expected {
+			if !strings.Contains(score, expected) {
+				t.Fatalf("cannot find %s in generated source:\n%s", expected, score)
+			}
+		}
+	}
+}
+
+func TestGenerateWithQuery(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	source := `
+name: account
+index:
+  primary_key: id
+members:
+- name: id
+  type: uint64
+- name: status
+  type: string
+- name: level
+  type: int
+queries:
+- name: find_by_status_and_min_level
+  sql: SELECT * FROM accounts WHERE status = :status AND level >= :level AND name <> ':status' AND level > :level::int ORDER BY id
+  params:
+  - name: status
+    type: string
+  - name: level
+    type: int
+- name: find_first_by_status
+  sql: SELECT * FROM accounts WHERE status = :status ORDER BY id LIMIT 1
+  params:
+  - name: status
+    type: string
+  return: one
+`
+	if err := ioutil.WriteFile(filepath.Join(classPath, "account.yml"), []byte(source), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, test := range []struct {
+		dialect  types.Dialect
+		expected []string
+	}{
+		{
+			dialect: types.DialectMySQL,
+			expected: []string{
+				"func (d *AccountImpl) FindByStatusAndMinLevel(ctx context.Context, a0 string, a1 int) (r entity.Accounts, e error) {",
+				"SELECT `id`, `status`, `level` FROM accounts WHERE status = ? AND level >= ? AND name <> ':status' AND level > ?::int ORDER BY id",
+				"rows, err := d.tx.QueryContext(ctx, query, a0, a1, a1)",
+				"func (d *AccountImpl) FindFirstByStatus(ctx context.Context, a0 string) (r *entity.Account, e error) {",
+				"d.tx.QueryRowContext(ctx, query, a0).Scan(",
+			},
+		},
+		{
+			dialect: types.DialectPostgres,
+			expected: []string{
+				`WHERE status = $1 AND level >= $2 AND name <> ':status' AND level > $3::int ORDER BY id`,
+			},
+		},
+	} {
+		outputPath := generateDAO(t, test.dialect, "account", source)
+		defer os.RemoveAll(outputPath)
+		account := readGenerated(t, outputPath, "account")
+		for _, expected := range test.expected {
+			if !strings.Contains(account, expected) {
+				t.Fatalf("cannot find %s in generated source:\n%s", expected, account)
+			}
+		}
+	}
+	t.Run("unknown parameter", func(t *testing.T) {
+		cfg := &config.Config{
+			ClassPath:  classPath,
+			OutputPath: classPath,
+		}
+		classes, err := class.NewReader().ClassByConfig(cfg)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		classes[0].Queries[0].SQL = "SELECT * FROM accounts WHERE status = :state"
+		if err := dao.NewGenerator(cfg).Generate(classes); err == nil {
+			t.Fatal("expected error for unknown parameter")
+		}
+	})
+	t.Run("datastore without query support", func(t *testing.T) {
+		cfg := &config.Config{
+			ClassPath:  classPath,
+			OutputPath: classPath,
+		}
+		classes, err := class.NewReader().ClassByConfig(cfg)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		classes[0].DataStore = "rapidash"
+		if err := dao.NewGenerator(cfg).Generate(classes); err == nil {
+			t.Fatal("expected error for queries of rapidash datastore")
+		}
+	})
+}
+
+func TestGenerateWithLockingRead(t *testing.T) {
+	source := `
+name: wallet
+locking_read: true
+index:
+  primary_key: id
+  unique_keys:
+  - - user_id
+    - currency
+members:
+- name: id
+  type: uint64
+- name: user_id
+  type: uint64
+- name: currency
+  type: string
+- name: balance
+  type: int64
+`
+	for _, test := range []struct {
+		dialect    types.Dialect
+		expected   []string
+		unexpected string
+	}{
+		{
+			dialect: types.DialectMySQL,
+			expected: []string{
+				"func (d *WalletImpl) FindByIDForUpdate(ctx context.Context, a0 uint64) (r *entity.Wallet, e error) {",
+				"func (d *WalletImpl) FindByIDsForShare(ctx context.Context, a0 []uint64) (r entity.Wallets, e error) {",
+				"func (d *WalletImpl) FindByUserIDAndCurrencyForUpdate(ctx context.Context, a0 uint64, a1 string) (r *entity.Wallet, e error) {",
+				"WHERE `id` = ? FOR UPDATE",
+				"WHERE `id` IN (%s) LOCK IN SHARE MODE",
+				"WHERE `user_id` = ? AND `currency` = ? LOCK IN SHARE MODE",
+			},
+			unexpected: "FindByUserIDsForUpdate",
+		},
+		{
+			dialect: types.DialectPostgres,
+			expected: []string{
+				`WHERE \"id\" = $1 FOR UPDATE`,
+				`WHERE \"id\" IN (%s) FOR SHARE`,
+			},
+		},
+	} {
+		outputPath := generateDAO(t, test.dialect, "wallet", source)
+		defer os.RemoveAll(outputPath)
+		wallet := readGenerated(t, outputPath, "wallet")
+		for _, expected := range test.expected {
+			if !strings.Contains(wallet, expected) {
+				t.Fatalf("cannot find %s in generated source:\n%s", expected, wallet)
+			}
+		}
+		if test.unexpected != "" && strings.Contains(wallet, test.unexpected) {
+			t.Fatalf("unexpected %s in generated source:\n%s", test.unexpected, wallet)
+		}
+	}
+	t.Run("SQLite doesn't support locking read", func(t *testing.T) {
+		classPath, err := ioutil.TempDir("", "eevee")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer os.RemoveAll(classPath)
+		if err := ioutil.WriteFile(filepath.Join(classPath, "wallet.yml"), []byte(source), 0644); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, test := range []struct {
+			datastore string
+			dialect   types.Dialect
+			expected  string
+		}{
+			{datastore: "sqlite", expected: "datastore sqlite of wallet doesn't support locking read"},
+			{datastore: "db", dialect: types.DialectSQLite, expected: "dialect sqlite of wallet doesn't support locking read"},
+		} {
+			cfg := &config.Config{
+				ClassPath:  classPath,
+				OutputPath: classPath,
+				Dialect:    test.dialect,
+			}
+			classes, err := class.NewReader().ClassByConfig(cfg)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			classes[0].DataStore = test.datastore
+			err = dao.NewGenerator(cfg).Generate(classes)
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Fatalf("expected error %q for locking_read of %s datastore, but got %v", test.expected, test.datastore, err)
+			}
+		}
+	})
+}
+
+func TestGenerateWithLockVersion(t *testing.T) {
+	source := `
+name: wallet
+index:
+  primary_key: id
+  unique_keys:
+  - - user_id
+members:
+- name: id
+  type: uint64
+- name: user_id
+  type: uint64
+- name: balance
+  type: int64
+- name: lock_version
+  type: int64
+  lock_version: true
+`
+	outputPath := generateDAO(t, types.DialectMySQL, "wallet", source)
+	defer os.RemoveAll(outputPath)
+	wallet := readGenerated(t, outputPath, "wallet")
+	for _, expected := range []string{
+		"UPDATE `wallets` SET `user_id` = ?, `balance` = ?, `lock_version` = `lock_version` + 1 WHERE `id` = ? AND `lock_version` = ?",
+		"RowsAffected()",
+		"ErrStaleObject",
+		"value.LockVersion++",
+		"ON DUPLICATE KEY UPDATE `balance` = VALUES(`balance`), `lock_version` = `lock_version` + 1",
+	} {
+		if !strings.Contains(wallet, expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, wallet)
+		}
+	}
+	t.Run("UpdateBy increments version", func(t *testing.T) {
+		if !strings.Contains(wallet, "columns = append(columns, \"`lock_version` = `lock_version` + 1\")") {
+			t.Fatalf("cannot find increment of version in generated source:\n%s", wallet)
+		}
+		if strings.Contains(wallet, "updateValue.LockVersion") {
+			t.Fatalf("version must not be specified by UpdateBy methods:\n%s", wallet)
+		}
+		entitySource, err := ioutil.ReadFile(filepath.Join(outputPath, "entity", "wallet.go"))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		updateStruct := string(entitySource)[strings.Index(string(entitySource), "type WalletUpdate struct"):]
+		updateStruct = updateStruct[:strings.Index(updateStruct, "}")]
+		if strings.Contains(updateStruct, "LockVersion") {
+			t.Fatalf("WalletUpdate must not have version:\n%s", updateStruct)
+		}
+	})
+	t.Run("Upsert increments version", func(t *testing.T) {
+		if strings.Contains(wallet, "`lock_version` = VALUES(`lock_version`)") {
+			t.Fatalf("version must not be overwritten by Upsert:\n%s", wallet)
+		}
+		outputPath := generateDAO(t, types.DialectPostgres, "wallet", source)
+		defer os.RemoveAll(outputPath)
+		wallet := readGenerated(t, outputPath, "wallet")
+		expected := `ON CONFLICT (\"user_id\") DO UPDATE SET \"balance\" = excluded.\"balance\", \"lock_version\" = \"wallets\".\"lock_version\" + 1`
+		if !strings.Contains(wallet, expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, wallet)
+		}
+	})
+	if daoSource := readGenerated(t, outputPath, "dao"); !strings.Contains(daoSource, `var ErrStaleObject = xerrors.New("stale object")`) {
+		t.Fatalf("cannot find ErrStaleObject in generated source:\n%s", daoSource)
+	}
+
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	invalid := strings.Replace(source, "- name: lock_version\n  type: int64", "- name: lock_version\n  type: string", 1)
+	if err := ioutil.WriteFile(filepath.Join(classPath, "wallet.yml"), []byte(invalid), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cfg := &config.Config{
+		ClassPath:  classPath,
+		OutputPath: outputPath,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err == nil {
+		t.Fatal("expected error for lock_version member which is not integer")
+	}
+}
+
+func TestGenerateUpsertWithCreatedAt(t *testing.T) {
+	source := `
+name: event
+index:
+  primary_key: id
+  unique_keys:
+  - - name
+members:
+- name: id
+  type: uint64
+  auto_increment: true
+- name: name
+  type: string
+- name: value
+  type: string
+- name: created_at
+  type:
+    import: time
+    package_name: time
+    name: Time
+`
+	for _, test := range []struct {
+		dialect  types.Dialect
+		expected string
+	}{
+		{
+			dialect:  types.DialectMySQL,
+			expected: "ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `value` = VALUES(`value`)\"",
+		},
+		{
+			dialect:  types.DialectPostgres,
+			expected: `ON CONFLICT (\"name\") DO UPDATE SET \"value\" = excluded.\"value\" RETURNING \"id\""`,
+		},
+		{
+			dialect:  types.DialectSQLite,
+			expected: `ON CONFLICT (\"name\") DO UPDATE SET \"value\" = excluded.\"value\""`,
+		},
+	} {
+		outputPath := generateDAO(t, test.dialect, "event", source)
+		defer os.RemoveAll(outputPath)
+		event := readGenerated(t, outputPath, "event")
+		if !strings.Contains(event, test.expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", test.expected, event)
+		}
+		if test.dialect != types.DialectSQLite {
+			continue
+		}
+		// run generated query to check that created_at of existing record is preserved on conflict
+		matched := regexp.MustCompile(`query := ("INSERT INTO .* ON CONFLICT .*")\n`).FindStringSubmatch(event)
+		if matched == nil {
+			t.Fatalf("cannot find upsert query in generated source:\n%s", event)
+		}
+		query, err := strconv.Unquote(matched[1])
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		db, err := sql.Open("sqlite3", ":memory:")
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		defer db.Close()
+		if _, err := db.Exec(`CREATE TABLE events (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL UNIQUE, value TEXT NOT NULL, created_at DATETIME NOT NULL)`); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		createdAt := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+		if _, err := db.Exec(query, "a", "x", createdAt); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := db.Exec(query, "a", "y", createdAt.Add(time.Hour)); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		var (
+			value   string
+			current time.Time
+		)
+		if err := db.QueryRow("SELECT value, created_at FROM events WHERE name = ?", "a").Scan(&value, &current); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if value != "y" || !current.Equal(createdAt) {
+			t.Fatalf("created_at must be preserved on conflict: value = %s, created_at = %s", value, current)
+		}
+	}
+}
+
+func TestGenerateUpdateByWithHelper(t *testing.T) {
+	source := `
+name: item
+index:
+  primary_key: id
+members:
+- name: id
+  type: uint64
+- name: name
+  type: string
+- name: count
+  type: int64
+`
+	outputPath := generateDAO(t, types.DialectMySQL, "item", source)
+	defer os.RemoveAll(outputPath)
+	item := readGenerated(t, outputPath, "item")
+	for _, expected := range []string{
+		"func (d *ItemImpl) updateColumns(updateValue *entity.ItemUpdate, args []interface{}) ([]string, []interface{}) {",
+		"if updateValue == nil {",
+		"columns, args := d.updateColumns(updateValue, args)",
+		"if len(columns) == 0 {",
+	} {
+		if !strings.Contains(item, expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, item)
+		}
+	}
+	if n := strings.Count(item, "if updateValue.Name != nil {"); n != 1 {
+		t.Fatalf("expected SET clause of name is built only by helper but found %d times:\n%s", n, item)
+	}
+	if strings.Contains(item, "\tupdateColumns(") {
+		t.Fatalf("helper must not be declared in interface:\n%s", item)
+	}
+}
+
+const generatedModulePath = "example.com/app"
+
+// generateDAO generates dao package of the class in a temporary directory and returns the directory.
+// entity package is generated together and both packages are type-checked,
+// so generated code that cannot be compiled fails the test before its source is checked.
+func generateDAO(t *testing.T, dialect types.Dialect, name, source string) string {
+	t.Helper()
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	if err := ioutil.WriteFile(filepath.Join(classPath, fmt.Sprintf("%s.yml", name)), []byte(source), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	outputPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cfg := &config.Config{
+		ModulePath: generatedModulePath,
+		ClassPath:  classPath,
+		OutputPath: outputPath,
+		Dialect:    dialect,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		os.RemoveAll(outputPath)
+		t.Fatalf("%+v", err)
+	}
+	if err := entity.NewGenerator(cfg).Generate(classes); err != nil {
+		os.RemoveAll(outputPath)
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		os.RemoveAll(outputPath)
+		t.Fatalf("%+v", err)
+	}
+	imp := &generatedImporter{
+		fset:       token.NewFileSet(),
+		outputPath: outputPath,
+		pkgs:       map[string]*gotypes.Package{},
+	}
+	if _, err := imp.Import(path.Join(generatedModulePath, "dao")); err != nil {
+		os.RemoveAll(outputPath)
+		t.Fatalf("generated package cannot be compiled: %+v", err)
+	}
+	return outputPath
+}
+
+// readGenerated returns source of file generated by generateDAO.
+func readGenerated(t *testing.T, outputPath, name string) string {
+	t.Helper()
+	source, err := ioutil.ReadFile(filepath.Join(outputPath, "dao", fmt.Sprintf("%s.go", name)))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return string(source)
+}
+
+// sourceImporter imports packages other than generated ones from source.
+// it is shared by tests because type-checking standard packages takes time.
+var sourceImporter = importer.ForCompiler(token.NewFileSet(), "source", nil).(gotypes.ImporterFrom)
+
+// generatedImporter type-checks packages generated under outputPath.
+type generatedImporter struct {
+	fset       *token.FileSet
+	outputPath string
+	pkgs       map[string]*gotypes.Package
+}
+
+func (i *generatedImporter) Import(importPath string) (*gotypes.Package, error) {
+	if !strings.HasPrefix(importPath, generatedModulePath+"/") {
+		// resolve dependencies of generated code ( e.g. golang.org/x/xerrors ) by go.mod of eevee
+		return sourceImporter.ImportFrom(importPath, ".", 0)
+	}
+	if pkg, exists := i.pkgs[importPath]; exists {
+		return pkg, nil
+	}
+	dir := filepath.Join(i.outputPath, strings.TrimPrefix(importPath, generatedModulePath+"/"))
+	pkgs, err := parser.ParseDir(i.fset, dir, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pkgs {
+		files := []*ast.File{}
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+		pkg, err := (&gotypes.Config{Importer: i}).Check(importPath, i.fset, files, nil)
+		if err != nil {
+			return nil, err
+		}
+		i.pkgs[importPath] = pkg
+		return pkg, nil
+	}
+	return nil, fmt.Errorf("cannot find package %s in %s", importPath, dir)
+}
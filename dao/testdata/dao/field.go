@@ -13,6 +13,7 @@ import (
 type Field interface {
 	Count(context.Context) (int64, error)
 	Create(context.Context, *entity.Field) error
+	CreateMulti(context.Context, entity.Fields) error
 	Delete(context.Context, *entity.Field) error
 	DeleteByDifficultyAndLevel(context.Context, int, int) error
 	DeleteByID(context.Context, uint64) error
@@ -23,27 +24,39 @@ type Field interface {
 	DeleteByObjectNum(context.Context, int) error
 	DeleteByObjectNums(context.Context, []int) error
 	FindAll(context.Context) (entity.Fields, error)
+	FindAllWithOption(context.Context, *entity.FieldFindOption) (entity.Fields, error)
 	FindByDifficulties(context.Context, []int) (entity.Fields, error)
+	FindByDifficultiesWithOption(context.Context, []int, *entity.FieldFindOption) (entity.Fields, error)
 	FindByDifficulty(context.Context, int) (entity.Fields, error)
 	FindByDifficultyAndLevel(context.Context, int, int) (entity.Fields, error)
+	FindByDifficultyAndLevelWithOption(context.Context, int, int, *entity.FieldFindOption) (entity.Fields, error)
+	FindByDifficultyWithOption(context.Context, int, *entity.FieldFindOption) (entity.Fields, error)
 	FindByID(context.Context, uint64) (*entity.Field, error)
 	FindByIDs(context.Context, []uint64) (entity.Fields, error)
+	FindByIDsWithOption(context.Context, []uint64, *entity.FieldFindOption) (entity.Fields, error)
 	FindByLocationX(context.Context, int) (entity.Fields, error)
 	FindByLocationXAndLocationY(context.Context, int, int) (*entity.Field, error)
+	FindByLocationXWithOption(context.Context, int, *entity.FieldFindOption) (entity.Fields, error)
 	FindByLocationXes(context.Context, []int) (entity.Fields, error)
+	FindByLocationXesWithOption(context.Context, []int, *entity.FieldFindOption) (entity.Fields, error)
 	FindByName(context.Context, string) (*entity.Field, error)
 	FindByNames(context.Context, []string) (entity.Fields, error)
+	FindByNamesWithOption(context.Context, []string, *entity.FieldFindOption) (entity.Fields, error)
 	FindByObjectNum(context.Context, int) (entity.Fields, error)
+	FindByObjectNumWithOption(context.Context, int, *entity.FieldFindOption) (entity.Fields, error)
 	FindByObjectNums(context.Context, []int) (entity.Fields, error)
+	FindByObjectNumsWithOption(context.Context, []int, *entity.FieldFindOption) (entity.Fields, error)
 	Update(context.Context, *entity.Field) error
-	UpdateByDifficultyAndLevel(context.Context, int, int, map[string]interface{}) error
-	UpdateByID(context.Context, uint64, map[string]interface{}) error
-	UpdateByIDs(context.Context, []uint64, map[string]interface{}) error
-	UpdateByLocationXAndLocationY(context.Context, int, int, map[string]interface{}) error
-	UpdateByName(context.Context, string, map[string]interface{}) error
-	UpdateByNames(context.Context, []string, map[string]interface{}) error
-	UpdateByObjectNum(context.Context, int, map[string]interface{}) error
-	UpdateByObjectNums(context.Context, []int, map[string]interface{}) error
+	UpdateByDifficultyAndLevel(context.Context, int, int, *entity.FieldUpdate) error
+	UpdateByID(context.Context, uint64, *entity.FieldUpdate) error
+	UpdateByIDs(context.Context, []uint64, *entity.FieldUpdate) error
+	UpdateByLocationXAndLocationY(context.Context, int, int, *entity.FieldUpdate) error
+	UpdateByName(context.Context, string, *entity.FieldUpdate) error
+	UpdateByNames(context.Context, []string, *entity.FieldUpdate) error
+	UpdateByObjectNum(context.Context, int, *entity.FieldUpdate) error
+	UpdateByObjectNums(context.Context, []int, *entity.FieldUpdate) error
+	Upsert(context.Context, *entity.Field) error
+	UpsertMulti(context.Context, entity.Fields) error
 	ExtendMethod() error
 }
 
@@ -83,9 +96,50 @@ func (d *FieldImpl) Create(ctx context.Context, value *entity.Field) (e error) {
 	return nil
 }
 
+// generated by eevee
+func (d *FieldImpl) CreateMulti(ctx context.Context, values entity.Fields) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*7)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, value.ID, value.Name, value.LocationX, value.LocationY, value.ObjectNum, value.Level, value.Difficulty)
+		}
+		query := fmt.Sprintf("INSERT INTO `fields` (`id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty`) VALUES %s", strings.Join(placeholders, ", "))
+		assigned := 0
+		for _, value := range chunk {
+			if value.ID == 0 {
+				assigned++
+			}
+		}
+		if assigned > 0 && assigned < len(chunk) {
+			return xerrors.New("cannot create records with and without id at once")
+		}
+		result, err := d.tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+		if assigned > 0 {
+			id, err := result.LastInsertId()
+			if err != nil {
+				return xerrors.Errorf("cannot get LastInsertId: %w", err)
+			}
+			for i, value := range chunk {
+				value.ID = uint64(id + int64(i))
+			}
+		}
+	}
+	return nil
+}
+
 // generated by eevee
 func (d *FieldImpl) Delete(ctx context.Context, value *entity.Field) (e error) {
-	query := "DELETE FROM `fields` WHERE id = ?"
+	query := "DELETE FROM `fields` WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, value.ID); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -205,6 +259,103 @@ func (d *FieldImpl) FindAll(ctx context.Context) (r entity.Fields, e error) {
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindAllWithOption(ctx context.Context, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	query := "SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields`"
+	args := []interface{}{}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" WHERE (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(&value.ID, &value.Name, &value.LocationX, &value.LocationY, &value.ObjectNum, &value.Level, &value.Difficulty); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByDifficulties(ctx context.Context, a0 []int) (r entity.Fields, e error) {
 	values := entity.Fields{}
@@ -243,6 +394,116 @@ func (d *FieldImpl) FindByDifficulties(ctx context.Context, a0 []int) (r entity.
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByDifficultiesWithOption(ctx context.Context, a0 []int, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `difficulty` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByDifficulty(ctx context.Context, a0 int) (r entity.Fields, e error) {
 	values := entity.Fields{}
@@ -305,6 +566,216 @@ func (d *FieldImpl) FindByDifficultyAndLevel(ctx context.Context, a0 int, a1 int
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByDifficultyAndLevelWithOption(ctx context.Context, a0 int, a1 int, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	query := "SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `difficulty` = ? AND `level` = ?"
+	args := []interface{}{a0, a1}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *FieldImpl) FindByDifficultyWithOption(ctx context.Context, a0 int, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	query := "SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `difficulty` = ?"
+	args := []interface{}{a0}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByID(ctx context.Context, a0 uint64) (r *entity.Field, e error) {
 	var value entity.Field
@@ -364,6 +835,116 @@ func (d *FieldImpl) FindByIDs(ctx context.Context, a0 []uint64) (r entity.Fields
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByLocationX(ctx context.Context, a0 int) (r entity.Fields, e error) {
 	values := entity.Fields{}
@@ -416,6 +997,111 @@ func (d *FieldImpl) FindByLocationXAndLocationY(ctx context.Context, a0 int, a1
 	return &value, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByLocationXWithOption(ctx context.Context, a0 int, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	query := "SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `location_x` = ?"
+	args := []interface{}{a0}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByLocationXes(ctx context.Context, a0 []int) (r entity.Fields, e error) {
 	values := entity.Fields{}
@@ -454,6 +1140,116 @@ func (d *FieldImpl) FindByLocationXes(ctx context.Context, a0 []int) (r entity.F
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByLocationXesWithOption(ctx context.Context, a0 []int, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `location_x` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByName(ctx context.Context, a0 string) (r *entity.Field, e error) {
 	var value entity.Field
@@ -513,6 +1309,116 @@ func (d *FieldImpl) FindByNames(ctx context.Context, a0 []string) (r entity.Fiel
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByNamesWithOption(ctx context.Context, a0 []string, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `name` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByObjectNum(ctx context.Context, a0 int) (r entity.Fields, e error) {
 	values := entity.Fields{}
@@ -544,6 +1450,111 @@ func (d *FieldImpl) FindByObjectNum(ctx context.Context, a0 int) (r entity.Field
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByObjectNumWithOption(ctx context.Context, a0 int, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	query := "SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `object_num` = ?"
+	args := []interface{}{a0}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) FindByObjectNums(ctx context.Context, a0 []int) (r entity.Fields, e error) {
 	values := entity.Fields{}
@@ -582,10 +1593,120 @@ func (d *FieldImpl) FindByObjectNums(ctx context.Context, a0 []int) (r entity.Fi
 	return values, nil
 }
 
+// generated by eevee
+func (d *FieldImpl) FindByObjectNumsWithOption(ctx context.Context, a0 []int, opt *entity.FieldFindOption) (r entity.Fields, e error) {
+	values := entity.Fields{}
+	if opt == nil {
+		opt = &entity.FieldFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty` FROM `fields` WHERE `object_num` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.FieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.FieldOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationX:
+		orderColumns = []string{"`location_x`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationX, opt.After.ID}
+		}
+	case entity.FieldOrderByLocationY:
+		orderColumns = []string{"`location_y`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.LocationY, opt.After.ID}
+		}
+	case entity.FieldOrderByObjectNum:
+		orderColumns = []string{"`object_num`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ObjectNum, opt.After.ID}
+		}
+	case entity.FieldOrderByLevel:
+		orderColumns = []string{"`level`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Level, opt.After.ID}
+		}
+	case entity.FieldOrderByDifficulty:
+		orderColumns = []string{"`difficulty`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Difficulty, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Field
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.LocationX,
+			&value.LocationY,
+			&value.ObjectNum,
+			&value.Level,
+			&value.Difficulty,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *FieldImpl) Update(ctx context.Context, value *entity.Field) (e error) {
 	args := []interface{}{value.Name, value.LocationX, value.LocationY, value.ObjectNum, value.Level, value.Difficulty, value.ID}
-	query := "UPDATE `fields` SET `name` = ?, `location_x` = ?, `location_y` = ?, `object_num` = ?, `level` = ?, `difficulty` = ? WHERE id = ?"
+	query := "UPDATE `fields` SET `name` = ?, `location_x` = ?, `location_y` = ?, `object_num` = ?, `level` = ?, `difficulty` = ? WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -593,12 +1714,14 @@ func (d *FieldImpl) Update(ctx context.Context, value *entity.Field) (e error) {
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByDifficultyAndLevel(ctx context.Context, a0 int, a1 int, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByDifficultyAndLevel(ctx context.Context, a0 int, a1 int, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	args = append(args, a1)
@@ -610,12 +1733,14 @@ func (d *FieldImpl) UpdateByDifficultyAndLevel(ctx context.Context, a0 int, a1 i
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByID(ctx context.Context, a0 uint64, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `fields` SET %s WHERE `id` = ?", strings.Join(columns, ", "))
@@ -626,12 +1751,14 @@ func (d *FieldImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[str
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -648,12 +1775,14 @@ func (d *FieldImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByLocationXAndLocationY(ctx context.Context, a0 int, a1 int, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByLocationXAndLocationY(ctx context.Context, a0 int, a1 int, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	args = append(args, a1)
@@ -665,12 +1794,14 @@ func (d *FieldImpl) UpdateByLocationXAndLocationY(ctx context.Context, a0 int, a
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByName(ctx context.Context, a0 string, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByName(ctx context.Context, a0 string, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `fields` SET %s WHERE `name` = ?", strings.Join(columns, ", "))
@@ -681,12 +1812,14 @@ func (d *FieldImpl) UpdateByName(ctx context.Context, a0 string, updateMap map[s
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByNames(ctx context.Context, a0 []string, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByNames(ctx context.Context, a0 []string, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -703,12 +1836,14 @@ func (d *FieldImpl) UpdateByNames(ctx context.Context, a0 []string, updateMap ma
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByObjectNum(ctx context.Context, a0 int, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByObjectNum(ctx context.Context, a0 int, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `fields` SET %s WHERE `object_num` = ?", strings.Join(columns, ", "))
@@ -719,12 +1854,14 @@ func (d *FieldImpl) UpdateByObjectNum(ctx context.Context, a0 int, updateMap map
 }
 
 // generated by eevee
-func (d *FieldImpl) UpdateByObjectNums(ctx context.Context, a0 []int, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *FieldImpl) UpdateByObjectNums(ctx context.Context, a0 []int, updateValue *entity.FieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -740,6 +1877,77 @@ func (d *FieldImpl) UpdateByObjectNums(ctx context.Context, a0 []int, updateMap
 	return nil
 }
 
+// generated by eevee
+func (d *FieldImpl) Upsert(ctx context.Context, value *entity.Field) (e error) {
+	query := "INSERT INTO `fields` (`id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty`) VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `location_x` = VALUES(`location_x`), `location_y` = VALUES(`location_y`), `object_num` = VALUES(`object_num`), `level` = VALUES(`level`), `difficulty` = VALUES(`difficulty`)"
+	result, err := d.tx.ExecContext(ctx, query, value.ID, value.Name, value.LocationX, value.LocationY, value.ObjectNum, value.Level, value.Difficulty)
+	if err != nil {
+		return xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return xerrors.Errorf("cannot get LastInsertId: %w", err)
+	}
+	value.ID = uint64(id)
+	return nil
+}
+
+// generated by eevee
+func (d *FieldImpl) UpsertMulti(ctx context.Context, values entity.Fields) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*7)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, value.ID, value.Name, value.LocationX, value.LocationY, value.ObjectNum, value.Level, value.Difficulty)
+		}
+		query := fmt.Sprintf("INSERT INTO `fields` (`id`, `name`, `location_x`, `location_y`, `object_num`, `level`, `difficulty`) VALUES %s ON DUPLICATE KEY UPDATE `location_x` = VALUES(`location_x`), `location_y` = VALUES(`location_y`), `object_num` = VALUES(`object_num`), `level` = VALUES(`level`), `difficulty` = VALUES(`difficulty`)", strings.Join(placeholders, ", "))
+		if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+	}
+	return nil
+}
+
+// generated by eevee
+func (d *FieldImpl) updateColumns(updateValue *entity.FieldUpdate, args []interface{}) ([]string, []interface{}) {
+	columns := []string{}
+	if updateValue.ID != nil {
+		args = append(args, *updateValue.ID)
+		columns = append(columns, "`id` = ?")
+	}
+	if updateValue.Name != nil {
+		args = append(args, *updateValue.Name)
+		columns = append(columns, "`name` = ?")
+	}
+	if updateValue.LocationX != nil {
+		args = append(args, *updateValue.LocationX)
+		columns = append(columns, "`location_x` = ?")
+	}
+	if updateValue.LocationY != nil {
+		args = append(args, *updateValue.LocationY)
+		columns = append(columns, "`location_y` = ?")
+	}
+	if updateValue.ObjectNum != nil {
+		args = append(args, *updateValue.ObjectNum)
+		columns = append(columns, "`object_num` = ?")
+	}
+	if updateValue.Level != nil {
+		args = append(args, *updateValue.Level)
+		columns = append(columns, "`level` = ?")
+	}
+	if updateValue.Difficulty != nil {
+		args = append(args, *updateValue.Difficulty)
+		columns = append(columns, "`difficulty` = ?")
+	}
+	return columns, args
+}
+
 func (d *FieldImpl) ExtendMethod() error {
 	fmt.Println("ext")
 	return nil
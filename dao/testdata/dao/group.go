@@ -13,15 +13,18 @@ import (
 type Group interface {
 	Count(context.Context) (int64, error)
 	Create(context.Context, *entity.Group) error
+	CreateMulti(context.Context, entity.Groups) error
 	Delete(context.Context, *entity.Group) error
 	DeleteByID(context.Context, uint64) error
 	DeleteByIDs(context.Context, []uint64) error
 	FindAll(context.Context) (entity.Groups, error)
+	FindAllWithOption(context.Context, *entity.GroupFindOption) (entity.Groups, error)
 	FindByID(context.Context, uint64) (*entity.Group, error)
 	FindByIDs(context.Context, []uint64) (entity.Groups, error)
+	FindByIDsWithOption(context.Context, []uint64, *entity.GroupFindOption) (entity.Groups, error)
 	Update(context.Context, *entity.Group) error
-	UpdateByID(context.Context, uint64, map[string]interface{}) error
-	UpdateByIDs(context.Context, []uint64, map[string]interface{}) error
+	UpdateByID(context.Context, uint64, *entity.GroupUpdate) error
+	UpdateByIDs(context.Context, []uint64, *entity.GroupUpdate) error
 }
 
 type GroupImpl struct {
@@ -60,9 +63,50 @@ func (d *GroupImpl) Create(ctx context.Context, value *entity.Group) (e error) {
 	return nil
 }
 
+// generated by eevee
+func (d *GroupImpl) CreateMulti(ctx context.Context, values entity.Groups) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*2)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?)")
+			args = append(args, value.ID, value.Name)
+		}
+		query := fmt.Sprintf("INSERT INTO `groups` (`id`, `name`) VALUES %s", strings.Join(placeholders, ", "))
+		assigned := 0
+		for _, value := range chunk {
+			if value.ID == 0 {
+				assigned++
+			}
+		}
+		if assigned > 0 && assigned < len(chunk) {
+			return xerrors.New("cannot create records with and without id at once")
+		}
+		result, err := d.tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+		if assigned > 0 {
+			id, err := result.LastInsertId()
+			if err != nil {
+				return xerrors.Errorf("cannot get LastInsertId: %w", err)
+			}
+			for i, value := range chunk {
+				value.ID = uint64(id + int64(i))
+			}
+		}
+	}
+	return nil
+}
+
 // generated by eevee
 func (d *GroupImpl) Delete(ctx context.Context, value *entity.Group) (e error) {
-	query := "DELETE FROM `groups` WHERE id = ?"
+	query := "DELETE FROM `groups` WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, value.ID); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -116,6 +160,73 @@ func (d *GroupImpl) FindAll(ctx context.Context) (r entity.Groups, e error) {
 	return values, nil
 }
 
+// generated by eevee
+func (d *GroupImpl) FindAllWithOption(ctx context.Context, opt *entity.GroupFindOption) (r entity.Groups, e error) {
+	values := entity.Groups{}
+	if opt == nil {
+		opt = &entity.GroupFindOption{}
+	}
+	query := "SELECT `id`, `name` FROM `groups`"
+	args := []interface{}{}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.GroupOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort groups by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" WHERE (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Group
+		if err := rows.Scan(&value.ID, &value.Name); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *GroupImpl) FindByID(ctx context.Context, a0 uint64) (r *entity.Group, e error) {
 	var value entity.Group
@@ -165,10 +276,85 @@ func (d *GroupImpl) FindByIDs(ctx context.Context, a0 []uint64) (r entity.Groups
 	return values, nil
 }
 
+// generated by eevee
+func (d *GroupImpl) FindByIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.GroupFindOption) (r entity.Groups, e error) {
+	values := entity.Groups{}
+	if opt == nil {
+		opt = &entity.GroupFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name` FROM `groups` WHERE `id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.GroupOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort groups by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Group
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *GroupImpl) Update(ctx context.Context, value *entity.Group) (e error) {
 	args := []interface{}{value.Name, value.ID}
-	query := "UPDATE `groups` SET `name` = ? WHERE id = ?"
+	query := "UPDATE `groups` SET `name` = ? WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -176,12 +362,14 @@ func (d *GroupImpl) Update(ctx context.Context, value *entity.Group) (e error) {
 }
 
 // generated by eevee
-func (d *GroupImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *GroupImpl) UpdateByID(ctx context.Context, a0 uint64, updateValue *entity.GroupUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `groups` SET %s WHERE `id` = ?", strings.Join(columns, ", "))
@@ -192,12 +380,14 @@ func (d *GroupImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[str
 }
 
 // generated by eevee
-func (d *GroupImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *GroupImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateValue *entity.GroupUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -212,3 +402,17 @@ func (d *GroupImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[
 	}
 	return nil
 }
+
+// generated by eevee
+func (d *GroupImpl) updateColumns(updateValue *entity.GroupUpdate, args []interface{}) ([]string, []interface{}) {
+	columns := []string{}
+	if updateValue.ID != nil {
+		args = append(args, *updateValue.ID)
+		columns = append(columns, "`id` = ?")
+	}
+	if updateValue.Name != nil {
+		args = append(args, *updateValue.Name)
+		columns = append(columns, "`name` = ?")
+	}
+	return columns, args
+}
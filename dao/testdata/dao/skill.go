@@ -13,15 +13,18 @@ import (
 type Skill interface {
 	Count(context.Context) (int64, error)
 	Create(context.Context, *entity.Skill) error
+	CreateMulti(context.Context, entity.Skills) error
 	Delete(context.Context, *entity.Skill) error
 	DeleteByID(context.Context, uint64) error
 	DeleteByIDs(context.Context, []uint64) error
 	FindAll(context.Context) (entity.Skills, error)
+	FindAllWithOption(context.Context, *entity.SkillFindOption) (entity.Skills, error)
 	FindByID(context.Context, uint64) (*entity.Skill, error)
 	FindByIDs(context.Context, []uint64) (entity.Skills, error)
+	FindByIDsWithOption(context.Context, []uint64, *entity.SkillFindOption) (entity.Skills, error)
 	Update(context.Context, *entity.Skill) error
-	UpdateByID(context.Context, uint64, map[string]interface{}) error
-	UpdateByIDs(context.Context, []uint64, map[string]interface{}) error
+	UpdateByID(context.Context, uint64, *entity.SkillUpdate) error
+	UpdateByIDs(context.Context, []uint64, *entity.SkillUpdate) error
 }
 
 type SkillImpl struct {
@@ -60,9 +63,50 @@ func (d *SkillImpl) Create(ctx context.Context, value *entity.Skill) (e error) {
 	return nil
 }
 
+// generated by eevee
+func (d *SkillImpl) CreateMulti(ctx context.Context, values entity.Skills) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*2)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?)")
+			args = append(args, value.ID, value.SkillEffect)
+		}
+		query := fmt.Sprintf("INSERT INTO `skills` (`id`, `skill_effect`) VALUES %s", strings.Join(placeholders, ", "))
+		assigned := 0
+		for _, value := range chunk {
+			if value.ID == 0 {
+				assigned++
+			}
+		}
+		if assigned > 0 && assigned < len(chunk) {
+			return xerrors.New("cannot create records with and without id at once")
+		}
+		result, err := d.tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+		if assigned > 0 {
+			id, err := result.LastInsertId()
+			if err != nil {
+				return xerrors.Errorf("cannot get LastInsertId: %w", err)
+			}
+			for i, value := range chunk {
+				value.ID = uint64(id + int64(i))
+			}
+		}
+	}
+	return nil
+}
+
 // generated by eevee
 func (d *SkillImpl) Delete(ctx context.Context, value *entity.Skill) (e error) {
-	query := "DELETE FROM `skills` WHERE id = ?"
+	query := "DELETE FROM `skills` WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, value.ID); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -116,6 +160,73 @@ func (d *SkillImpl) FindAll(ctx context.Context) (r entity.Skills, e error) {
 	return values, nil
 }
 
+// generated by eevee
+func (d *SkillImpl) FindAllWithOption(ctx context.Context, opt *entity.SkillFindOption) (r entity.Skills, e error) {
+	values := entity.Skills{}
+	if opt == nil {
+		opt = &entity.SkillFindOption{}
+	}
+	query := "SELECT `id`, `skill_effect` FROM `skills`"
+	args := []interface{}{}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.SkillOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort skills by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" WHERE (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Skill
+		if err := rows.Scan(&value.ID, &value.SkillEffect); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *SkillImpl) FindByID(ctx context.Context, a0 uint64) (r *entity.Skill, e error) {
 	var value entity.Skill
@@ -165,10 +276,85 @@ func (d *SkillImpl) FindByIDs(ctx context.Context, a0 []uint64) (r entity.Skills
 	return values, nil
 }
 
+// generated by eevee
+func (d *SkillImpl) FindByIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.SkillFindOption) (r entity.Skills, e error) {
+	values := entity.Skills{}
+	if opt == nil {
+		opt = &entity.SkillFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `skill_effect` FROM `skills` WHERE `id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.SkillOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort skills by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.Skill
+		if err := rows.Scan(
+			&value.ID,
+			&value.SkillEffect,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *SkillImpl) Update(ctx context.Context, value *entity.Skill) (e error) {
 	args := []interface{}{value.SkillEffect, value.ID}
-	query := "UPDATE `skills` SET `skill_effect` = ? WHERE id = ?"
+	query := "UPDATE `skills` SET `skill_effect` = ? WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -176,12 +362,14 @@ func (d *SkillImpl) Update(ctx context.Context, value *entity.Skill) (e error) {
 }
 
 // generated by eevee
-func (d *SkillImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *SkillImpl) UpdateByID(ctx context.Context, a0 uint64, updateValue *entity.SkillUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `skills` SET %s WHERE `id` = ?", strings.Join(columns, ", "))
@@ -192,12 +380,14 @@ func (d *SkillImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[str
 }
 
 // generated by eevee
-func (d *SkillImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *SkillImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateValue *entity.SkillUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -212,3 +402,17 @@ func (d *SkillImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[
 	}
 	return nil
 }
+
+// generated by eevee
+func (d *SkillImpl) updateColumns(updateValue *entity.SkillUpdate, args []interface{}) ([]string, []interface{}) {
+	columns := []string{}
+	if updateValue.ID != nil {
+		args = append(args, *updateValue.ID)
+		columns = append(columns, "`id` = ?")
+	}
+	if updateValue.SkillEffect != nil {
+		args = append(args, *updateValue.SkillEffect)
+		columns = append(columns, "`skill_effect` = ?")
+	}
+	return columns, args
+}
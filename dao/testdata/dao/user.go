@@ -13,6 +13,7 @@ import (
 type User interface {
 	Count(context.Context) (int64, error)
 	Create(context.Context, *entity.User) error
+	CreateMulti(context.Context, entity.Users) error
 	Delete(context.Context, *entity.User) error
 	DeleteByGroupID(context.Context, uint64) error
 	DeleteByGroupIDs(context.Context, []uint64) error
@@ -23,27 +24,39 @@ type User interface {
 	DeleteBySkillIDAndSkillRank(context.Context, uint64, int) error
 	DeleteByWorldIDAndFieldID(context.Context, uint64, uint64) error
 	FindAll(context.Context) (entity.Users, error)
+	FindAllWithOption(context.Context, *entity.UserFindOption) (entity.Users, error)
 	FindByGroupID(context.Context, uint64) (entity.Users, error)
+	FindByGroupIDWithOption(context.Context, uint64, *entity.UserFindOption) (entity.Users, error)
 	FindByGroupIDs(context.Context, []uint64) (entity.Users, error)
+	FindByGroupIDsWithOption(context.Context, []uint64, *entity.UserFindOption) (entity.Users, error)
 	FindByID(context.Context, uint64) (*entity.User, error)
 	FindByIDs(context.Context, []uint64) (entity.Users, error)
+	FindByIDsWithOption(context.Context, []uint64, *entity.UserFindOption) (entity.Users, error)
 	FindByName(context.Context, string) (*entity.User, error)
 	FindByNames(context.Context, []string) (entity.Users, error)
+	FindByNamesWithOption(context.Context, []string, *entity.UserFindOption) (entity.Users, error)
 	FindBySkillID(context.Context, uint64) (entity.Users, error)
 	FindBySkillIDAndSkillRank(context.Context, uint64, int) (*entity.User, error)
+	FindBySkillIDWithOption(context.Context, uint64, *entity.UserFindOption) (entity.Users, error)
 	FindBySkillIDs(context.Context, []uint64) (entity.Users, error)
+	FindBySkillIDsWithOption(context.Context, []uint64, *entity.UserFindOption) (entity.Users, error)
 	FindByWorldID(context.Context, uint64) (entity.Users, error)
 	FindByWorldIDAndFieldID(context.Context, uint64, uint64) (entity.Users, error)
+	FindByWorldIDAndFieldIDWithOption(context.Context, uint64, uint64, *entity.UserFindOption) (entity.Users, error)
+	FindByWorldIDWithOption(context.Context, uint64, *entity.UserFindOption) (entity.Users, error)
 	FindByWorldIDs(context.Context, []uint64) (entity.Users, error)
+	FindByWorldIDsWithOption(context.Context, []uint64, *entity.UserFindOption) (entity.Users, error)
 	Update(context.Context, *entity.User) error
-	UpdateByGroupID(context.Context, uint64, map[string]interface{}) error
-	UpdateByGroupIDs(context.Context, []uint64, map[string]interface{}) error
-	UpdateByID(context.Context, uint64, map[string]interface{}) error
-	UpdateByIDs(context.Context, []uint64, map[string]interface{}) error
-	UpdateByName(context.Context, string, map[string]interface{}) error
-	UpdateByNames(context.Context, []string, map[string]interface{}) error
-	UpdateBySkillIDAndSkillRank(context.Context, uint64, int, map[string]interface{}) error
-	UpdateByWorldIDAndFieldID(context.Context, uint64, uint64, map[string]interface{}) error
+	UpdateByGroupID(context.Context, uint64, *entity.UserUpdate) error
+	UpdateByGroupIDs(context.Context, []uint64, *entity.UserUpdate) error
+	UpdateByID(context.Context, uint64, *entity.UserUpdate) error
+	UpdateByIDs(context.Context, []uint64, *entity.UserUpdate) error
+	UpdateByName(context.Context, string, *entity.UserUpdate) error
+	UpdateByNames(context.Context, []string, *entity.UserUpdate) error
+	UpdateBySkillIDAndSkillRank(context.Context, uint64, int, *entity.UserUpdate) error
+	UpdateByWorldIDAndFieldID(context.Context, uint64, uint64, *entity.UserUpdate) error
+	Upsert(context.Context, *entity.User) error
+	UpsertMulti(context.Context, entity.Users) error
 }
 
 type UserImpl struct {
@@ -82,9 +95,50 @@ func (d *UserImpl) Create(ctx context.Context, value *entity.User) (e error) {
 	return nil
 }
 
+// generated by eevee
+func (d *UserImpl) CreateMulti(ctx context.Context, values entity.Users) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*9)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, value.ID, value.Name, value.Sex, value.Age, value.SkillID, value.SkillRank, value.GroupID, value.WorldID, value.FieldID)
+		}
+		query := fmt.Sprintf("INSERT INTO `users` (`id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id`) VALUES %s", strings.Join(placeholders, ", "))
+		assigned := 0
+		for _, value := range chunk {
+			if value.ID == 0 {
+				assigned++
+			}
+		}
+		if assigned > 0 && assigned < len(chunk) {
+			return xerrors.New("cannot create records with and without id at once")
+		}
+		result, err := d.tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+		if assigned > 0 {
+			id, err := result.LastInsertId()
+			if err != nil {
+				return xerrors.Errorf("cannot get LastInsertId: %w", err)
+			}
+			for i, value := range chunk {
+				value.ID = uint64(id + int64(i))
+			}
+		}
+	}
+	return nil
+}
+
 // generated by eevee
 func (d *UserImpl) Delete(ctx context.Context, value *entity.User) (e error) {
-	query := "DELETE FROM `users` WHERE id = ?"
+	query := "DELETE FROM `users` WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, value.ID); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -204,6 +258,103 @@ func (d *UserImpl) FindAll(ctx context.Context) (r entity.Users, e error) {
 	return values, nil
 }
 
+// generated by eevee
+func (d *UserImpl) FindAllWithOption(ctx context.Context, opt *entity.UserFindOption) (r entity.Users, e error) {
+	values := entity.Users{}
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users`"
+	args := []interface{}{}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" WHERE (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(&value.ID, &value.Name, &value.Sex, &value.Age, &value.SkillID, &value.SkillRank, &value.GroupID, &value.WorldID, &value.FieldID); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *UserImpl) FindByGroupID(ctx context.Context, a0 uint64) (r entity.Users, e error) {
 	values := entity.Users{}
@@ -237,6 +388,113 @@ func (d *UserImpl) FindByGroupID(ctx context.Context, a0 uint64) (r entity.Users
 	return values, nil
 }
 
+// generated by eevee
+func (d *UserImpl) FindByGroupIDWithOption(ctx context.Context, a0 uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
+	values := entity.Users{}
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `group_id` = ?"
+	args := []interface{}{a0}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *UserImpl) FindByGroupIDs(ctx context.Context, a0 []uint64) (r entity.Users, e error) {
 	values := entity.Users{}
@@ -278,40 +536,89 @@ func (d *UserImpl) FindByGroupIDs(ctx context.Context, a0 []uint64) (r entity.Us
 }
 
 // generated by eevee
-func (d *UserImpl) FindByID(ctx context.Context, a0 uint64) (r *entity.User, e error) {
-	var value entity.User
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `id` = ?"
-	if err := d.tx.QueryRowContext(ctx, query, a0).Scan(
-		&value.ID,
-		&value.Name,
-		&value.Sex,
-		&value.Age,
-		&value.SkillID,
-		&value.SkillRank,
-		&value.GroupID,
-		&value.WorldID,
-		&value.FieldID,
-	); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, xerrors.Errorf("failure query %s: %w", query, err)
-	}
-	return &value, nil
-}
-
-// generated by eevee
-func (d *UserImpl) FindByIDs(ctx context.Context, a0 []uint64) (r entity.Users, e error) {
+func (d *UserImpl) FindByGroupIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
 	values := entity.Users{}
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `id` IN (%s)"
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
 	args := []interface{}{}
 	placeholders := make([]string, 0, len(a0))
 	for _, v := range a0 {
 		args = append(args, v)
 		placeholders = append(placeholders, "?")
 	}
-	selectQuery := fmt.Sprintf(query, strings.Join(placeholders, ", "))
-	rows, err := d.tx.QueryContext(ctx, selectQuery, args...)
+	query := fmt.Sprintf("SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `group_id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
 	if err != nil {
 		return values, xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -341,9 +648,9 @@ func (d *UserImpl) FindByIDs(ctx context.Context, a0 []uint64) (r entity.Users,
 }
 
 // generated by eevee
-func (d *UserImpl) FindByName(ctx context.Context, a0 string) (r *entity.User, e error) {
+func (d *UserImpl) FindByID(ctx context.Context, a0 uint64) (r *entity.User, e error) {
 	var value entity.User
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `name` = ?"
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `id` = ?"
 	if err := d.tx.QueryRowContext(ctx, query, a0).Scan(
 		&value.ID,
 		&value.Name,
@@ -364,9 +671,9 @@ func (d *UserImpl) FindByName(ctx context.Context, a0 string) (r *entity.User, e
 }
 
 // generated by eevee
-func (d *UserImpl) FindByNames(ctx context.Context, a0 []string) (r entity.Users, e error) {
+func (d *UserImpl) FindByIDs(ctx context.Context, a0 []uint64) (r entity.Users, e error) {
 	values := entity.Users{}
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `name` IN (%s)"
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `id` IN (%s)"
 	args := []interface{}{}
 	placeholders := make([]string, 0, len(a0))
 	for _, v := range a0 {
@@ -404,10 +711,89 @@ func (d *UserImpl) FindByNames(ctx context.Context, a0 []string) (r entity.Users
 }
 
 // generated by eevee
-func (d *UserImpl) FindBySkillID(ctx context.Context, a0 uint64) (r entity.Users, e error) {
+func (d *UserImpl) FindByIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
 	values := entity.Users{}
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` = ?"
-	rows, err := d.tx.QueryContext(ctx, query, a0)
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
 	if err != nil {
 		return values, xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -437,10 +823,10 @@ func (d *UserImpl) FindBySkillID(ctx context.Context, a0 uint64) (r entity.Users
 }
 
 // generated by eevee
-func (d *UserImpl) FindBySkillIDAndSkillRank(ctx context.Context, a0 uint64, a1 int) (r *entity.User, e error) {
+func (d *UserImpl) FindByName(ctx context.Context, a0 string) (r *entity.User, e error) {
 	var value entity.User
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` = ? AND `skill_rank` = ?"
-	if err := d.tx.QueryRowContext(ctx, query, a0, a1).Scan(
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `name` = ?"
+	if err := d.tx.QueryRowContext(ctx, query, a0).Scan(
 		&value.ID,
 		&value.Name,
 		&value.Sex,
@@ -460,9 +846,9 @@ func (d *UserImpl) FindBySkillIDAndSkillRank(ctx context.Context, a0 uint64, a1
 }
 
 // generated by eevee
-func (d *UserImpl) FindBySkillIDs(ctx context.Context, a0 []uint64) (r entity.Users, e error) {
+func (d *UserImpl) FindByNames(ctx context.Context, a0 []string) (r entity.Users, e error) {
 	values := entity.Users{}
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` IN (%s)"
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `name` IN (%s)"
 	args := []interface{}{}
 	placeholders := make([]string, 0, len(a0))
 	for _, v := range a0 {
@@ -500,10 +886,89 @@ func (d *UserImpl) FindBySkillIDs(ctx context.Context, a0 []uint64) (r entity.Us
 }
 
 // generated by eevee
-func (d *UserImpl) FindByWorldID(ctx context.Context, a0 uint64) (r entity.Users, e error) {
+func (d *UserImpl) FindByNamesWithOption(ctx context.Context, a0 []string, opt *entity.UserFindOption) (r entity.Users, e error) {
 	values := entity.Users{}
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` = ?"
-	rows, err := d.tx.QueryContext(ctx, query, a0)
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `name` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
 	if err != nil {
 		return values, xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -533,10 +998,10 @@ func (d *UserImpl) FindByWorldID(ctx context.Context, a0 uint64) (r entity.Users
 }
 
 // generated by eevee
-func (d *UserImpl) FindByWorldIDAndFieldID(ctx context.Context, a0 uint64, a1 uint64) (r entity.Users, e error) {
+func (d *UserImpl) FindBySkillID(ctx context.Context, a0 uint64) (r entity.Users, e error) {
 	values := entity.Users{}
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` = ? AND `field_id` = ?"
-	rows, err := d.tx.QueryContext(ctx, query, a0, a1)
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` = ?"
+	rows, err := d.tx.QueryContext(ctx, query, a0)
 	if err != nil {
 		return values, xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -566,17 +1031,107 @@ func (d *UserImpl) FindByWorldIDAndFieldID(ctx context.Context, a0 uint64, a1 ui
 }
 
 // generated by eevee
-func (d *UserImpl) FindByWorldIDs(ctx context.Context, a0 []uint64) (r entity.Users, e error) {
+func (d *UserImpl) FindBySkillIDAndSkillRank(ctx context.Context, a0 uint64, a1 int) (r *entity.User, e error) {
+	var value entity.User
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` = ? AND `skill_rank` = ?"
+	if err := d.tx.QueryRowContext(ctx, query, a0, a1).Scan(
+		&value.ID,
+		&value.Name,
+		&value.Sex,
+		&value.Age,
+		&value.SkillID,
+		&value.SkillRank,
+		&value.GroupID,
+		&value.WorldID,
+		&value.FieldID,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	return &value, nil
+}
+
+// generated by eevee
+func (d *UserImpl) FindBySkillIDWithOption(ctx context.Context, a0 uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
 	values := entity.Users{}
-	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` IN (%s)"
-	args := []interface{}{}
-	placeholders := make([]string, 0, len(a0))
-	for _, v := range a0 {
-		args = append(args, v)
-		placeholders = append(placeholders, "?")
+	if opt == nil {
+		opt = &entity.UserFindOption{}
 	}
-	selectQuery := fmt.Sprintf(query, strings.Join(placeholders, ", "))
-	rows, err := d.tx.QueryContext(ctx, selectQuery, args...)
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` = ?"
+	args := []interface{}{a0}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
 	if err != nil {
 		return values, xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -606,22 +1161,608 @@ func (d *UserImpl) FindByWorldIDs(ctx context.Context, a0 []uint64) (r entity.Us
 }
 
 // generated by eevee
-func (d *UserImpl) Update(ctx context.Context, value *entity.User) (e error) {
-	args := []interface{}{value.Name, value.Sex, value.Age, value.SkillID, value.SkillRank, value.GroupID, value.WorldID, value.FieldID, value.ID}
-	query := "UPDATE `users` SET `name` = ?, `sex` = ?, `age` = ?, `skill_id` = ?, `skill_rank` = ?, `group_id` = ?, `world_id` = ?, `field_id` = ? WHERE id = ?"
-	if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
-		return xerrors.Errorf("failure query %s: %w", query, err)
+func (d *UserImpl) FindBySkillIDs(ctx context.Context, a0 []uint64) (r entity.Users, e error) {
+	values := entity.Users{}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` IN (%s)"
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
 	}
-	return nil
+	selectQuery := fmt.Sprintf(query, strings.Join(placeholders, ", "))
+	rows, err := d.tx.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateByGroupID(ctx context.Context, a0 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) FindBySkillIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
+	values := entity.Users{}
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `skill_id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *UserImpl) FindByWorldID(ctx context.Context, a0 uint64) (r entity.Users, e error) {
+	values := entity.Users{}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` = ?"
+	rows, err := d.tx.QueryContext(ctx, query, a0)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *UserImpl) FindByWorldIDAndFieldID(ctx context.Context, a0 uint64, a1 uint64) (r entity.Users, e error) {
+	values := entity.Users{}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` = ? AND `field_id` = ?"
+	rows, err := d.tx.QueryContext(ctx, query, a0, a1)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *UserImpl) FindByWorldIDAndFieldIDWithOption(ctx context.Context, a0 uint64, a1 uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
+	values := entity.Users{}
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` = ? AND `field_id` = ?"
+	args := []interface{}{a0, a1}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *UserImpl) FindByWorldIDWithOption(ctx context.Context, a0 uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
+	values := entity.Users{}
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` = ?"
+	args := []interface{}{a0}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *UserImpl) FindByWorldIDs(ctx context.Context, a0 []uint64) (r entity.Users, e error) {
+	values := entity.Users{}
+	query := "SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` IN (%s)"
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
 		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	selectQuery := fmt.Sprintf(query, strings.Join(placeholders, ", "))
+	rows, err := d.tx.QueryContext(ctx, selectQuery, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *UserImpl) FindByWorldIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.UserFindOption) (r entity.Users, e error) {
+	values := entity.Users{}
+	if opt == nil {
+		opt = &entity.UserFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id` FROM `users` WHERE `world_id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserOrderByName:
+		orderColumns = []string{"`name`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.Name, opt.After.ID}
+		}
+	case entity.UserOrderBySkillID:
+		orderColumns = []string{"`skill_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillID, opt.After.ID}
+		}
+	case entity.UserOrderBySkillRank:
+		orderColumns = []string{"`skill_rank`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.SkillRank, opt.After.ID}
+		}
+	case entity.UserOrderByGroupID:
+		orderColumns = []string{"`group_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.GroupID, opt.After.ID}
+		}
+	case entity.UserOrderByWorldID:
+		orderColumns = []string{"`world_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.WorldID, opt.After.ID}
+		}
+	case entity.UserOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort users by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.User
+		if err := rows.Scan(
+			&value.ID,
+			&value.Name,
+			&value.Sex,
+			&value.Age,
+			&value.SkillID,
+			&value.SkillRank,
+			&value.GroupID,
+			&value.WorldID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// generated by eevee
+func (d *UserImpl) Update(ctx context.Context, value *entity.User) (e error) {
+	args := []interface{}{value.Name, value.Sex, value.Age, value.SkillID, value.SkillRank, value.GroupID, value.WorldID, value.FieldID, value.ID}
+	query := "UPDATE `users` SET `name` = ?, `sex` = ?, `age` = ?, `skill_id` = ?, `skill_rank` = ?, `group_id` = ?, `world_id` = ?, `field_id` = ? WHERE `id` = ?"
+	if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
+		return xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	return nil
+}
+
+// generated by eevee
+func (d *UserImpl) UpdateByGroupID(ctx context.Context, a0 uint64, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
+	args := []interface{}{}
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `users` SET %s WHERE `group_id` = ?", strings.Join(columns, ", "))
@@ -632,12 +1773,14 @@ func (d *UserImpl) UpdateByGroupID(ctx context.Context, a0 uint64, updateMap map
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateByGroupIDs(ctx context.Context, a0 []uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) UpdateByGroupIDs(ctx context.Context, a0 []uint64, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -654,12 +1797,14 @@ func (d *UserImpl) UpdateByGroupIDs(ctx context.Context, a0 []uint64, updateMap
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) UpdateByID(ctx context.Context, a0 uint64, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `users` SET %s WHERE `id` = ?", strings.Join(columns, ", "))
@@ -670,12 +1815,14 @@ func (d *UserImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[stri
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -692,12 +1839,14 @@ func (d *UserImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[s
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateByName(ctx context.Context, a0 string, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) UpdateByName(ctx context.Context, a0 string, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `users` SET %s WHERE `name` = ?", strings.Join(columns, ", "))
@@ -708,12 +1857,14 @@ func (d *UserImpl) UpdateByName(ctx context.Context, a0 string, updateMap map[st
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateByNames(ctx context.Context, a0 []string, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) UpdateByNames(ctx context.Context, a0 []string, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -730,12 +1881,14 @@ func (d *UserImpl) UpdateByNames(ctx context.Context, a0 []string, updateMap map
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateBySkillIDAndSkillRank(ctx context.Context, a0 uint64, a1 int, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) UpdateBySkillIDAndSkillRank(ctx context.Context, a0 uint64, a1 int, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	args = append(args, a1)
@@ -747,12 +1900,14 @@ func (d *UserImpl) UpdateBySkillIDAndSkillRank(ctx context.Context, a0 uint64, a
 }
 
 // generated by eevee
-func (d *UserImpl) UpdateByWorldIDAndFieldID(ctx context.Context, a0 uint64, a1 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserImpl) UpdateByWorldIDAndFieldID(ctx context.Context, a0 uint64, a1 uint64, updateValue *entity.UserUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	args = append(args, a1)
@@ -762,3 +1917,82 @@ func (d *UserImpl) UpdateByWorldIDAndFieldID(ctx context.Context, a0 uint64, a1
 	}
 	return nil
 }
+
+// generated by eevee
+func (d *UserImpl) Upsert(ctx context.Context, value *entity.User) (e error) {
+	query := "INSERT INTO `users` (`id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `sex` = VALUES(`sex`), `age` = VALUES(`age`), `skill_id` = VALUES(`skill_id`), `skill_rank` = VALUES(`skill_rank`), `group_id` = VALUES(`group_id`), `world_id` = VALUES(`world_id`), `field_id` = VALUES(`field_id`)"
+	result, err := d.tx.ExecContext(ctx, query, value.ID, value.Name, value.Sex, value.Age, value.SkillID, value.SkillRank, value.GroupID, value.WorldID, value.FieldID)
+	if err != nil {
+		return xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return xerrors.Errorf("cannot get LastInsertId: %w", err)
+	}
+	value.ID = uint64(id)
+	return nil
+}
+
+// generated by eevee
+func (d *UserImpl) UpsertMulti(ctx context.Context, values entity.Users) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*9)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, value.ID, value.Name, value.Sex, value.Age, value.SkillID, value.SkillRank, value.GroupID, value.WorldID, value.FieldID)
+		}
+		query := fmt.Sprintf("INSERT INTO `users` (`id`, `name`, `sex`, `age`, `skill_id`, `skill_rank`, `group_id`, `world_id`, `field_id`) VALUES %s ON DUPLICATE KEY UPDATE `sex` = VALUES(`sex`), `age` = VALUES(`age`), `skill_id` = VALUES(`skill_id`), `skill_rank` = VALUES(`skill_rank`), `group_id` = VALUES(`group_id`), `world_id` = VALUES(`world_id`), `field_id` = VALUES(`field_id`)", strings.Join(placeholders, ", "))
+		if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+	}
+	return nil
+}
+
+// generated by eevee
+func (d *UserImpl) updateColumns(updateValue *entity.UserUpdate, args []interface{}) ([]string, []interface{}) {
+	columns := []string{}
+	if updateValue.ID != nil {
+		args = append(args, *updateValue.ID)
+		columns = append(columns, "`id` = ?")
+	}
+	if updateValue.Name != nil {
+		args = append(args, *updateValue.Name)
+		columns = append(columns, "`name` = ?")
+	}
+	if updateValue.Sex != nil {
+		args = append(args, *updateValue.Sex)
+		columns = append(columns, "`sex` = ?")
+	}
+	if updateValue.Age != nil {
+		args = append(args, *updateValue.Age)
+		columns = append(columns, "`age` = ?")
+	}
+	if updateValue.SkillID != nil {
+		args = append(args, *updateValue.SkillID)
+		columns = append(columns, "`skill_id` = ?")
+	}
+	if updateValue.SkillRank != nil {
+		args = append(args, *updateValue.SkillRank)
+		columns = append(columns, "`skill_rank` = ?")
+	}
+	if updateValue.GroupID != nil {
+		args = append(args, *updateValue.GroupID)
+		columns = append(columns, "`group_id` = ?")
+	}
+	if updateValue.WorldID != nil {
+		args = append(args, *updateValue.WorldID)
+		columns = append(columns, "`world_id` = ?")
+	}
+	if updateValue.FieldID != nil {
+		args = append(args, *updateValue.FieldID)
+		columns = append(columns, "`field_id` = ?")
+	}
+	return columns, args
+}
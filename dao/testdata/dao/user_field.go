@@ -13,20 +13,27 @@ import (
 type UserField interface {
 	Count(context.Context) (int64, error)
 	Create(context.Context, *entity.UserField) error
+	CreateMulti(context.Context, entity.UserFields) error
 	Delete(context.Context, *entity.UserField) error
 	DeleteByID(context.Context, uint64) error
 	DeleteByIDs(context.Context, []uint64) error
 	DeleteByUserIDAndFieldID(context.Context, uint64, uint64) error
 	FindAll(context.Context) (entity.UserFields, error)
+	FindAllWithOption(context.Context, *entity.UserFieldFindOption) (entity.UserFields, error)
 	FindByID(context.Context, uint64) (*entity.UserField, error)
 	FindByIDs(context.Context, []uint64) (entity.UserFields, error)
+	FindByIDsWithOption(context.Context, []uint64, *entity.UserFieldFindOption) (entity.UserFields, error)
 	FindByUserID(context.Context, uint64) (entity.UserFields, error)
 	FindByUserIDAndFieldID(context.Context, uint64, uint64) (*entity.UserField, error)
+	FindByUserIDWithOption(context.Context, uint64, *entity.UserFieldFindOption) (entity.UserFields, error)
 	FindByUserIDs(context.Context, []uint64) (entity.UserFields, error)
+	FindByUserIDsWithOption(context.Context, []uint64, *entity.UserFieldFindOption) (entity.UserFields, error)
 	Update(context.Context, *entity.UserField) error
-	UpdateByID(context.Context, uint64, map[string]interface{}) error
-	UpdateByIDs(context.Context, []uint64, map[string]interface{}) error
-	UpdateByUserIDAndFieldID(context.Context, uint64, uint64, map[string]interface{}) error
+	UpdateByID(context.Context, uint64, *entity.UserFieldUpdate) error
+	UpdateByIDs(context.Context, []uint64, *entity.UserFieldUpdate) error
+	UpdateByUserIDAndFieldID(context.Context, uint64, uint64, *entity.UserFieldUpdate) error
+	Upsert(context.Context, *entity.UserField) error
+	UpsertMulti(context.Context, entity.UserFields) error
 }
 
 type UserFieldImpl struct {
@@ -68,9 +75,50 @@ func (d *UserFieldImpl) Create(ctx context.Context, value *entity.UserField) (e
 	return nil
 }
 
+// generated by eevee
+func (d *UserFieldImpl) CreateMulti(ctx context.Context, values entity.UserFields) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*3)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?, ?)")
+			args = append(args, value.ID, value.UserID, value.FieldID)
+		}
+		query := fmt.Sprintf("INSERT INTO `user_fields` (`id`, `user_id`, `field_id`) VALUES %s", strings.Join(placeholders, ", "))
+		assigned := 0
+		for _, value := range chunk {
+			if value.ID == 0 {
+				assigned++
+			}
+		}
+		if assigned > 0 && assigned < len(chunk) {
+			return xerrors.New("cannot create records with and without id at once")
+		}
+		result, err := d.tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+		if assigned > 0 {
+			id, err := result.LastInsertId()
+			if err != nil {
+				return xerrors.Errorf("cannot get LastInsertId: %w", err)
+			}
+			for i, value := range chunk {
+				value.ID = uint64(id + int64(i))
+			}
+		}
+	}
+	return nil
+}
+
 // generated by eevee
 func (d *UserFieldImpl) Delete(ctx context.Context, value *entity.UserField) (e error) {
-	query := "DELETE FROM `user_fields` WHERE id = ?"
+	query := "DELETE FROM `user_fields` WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, value.ID); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -133,6 +181,83 @@ func (d *UserFieldImpl) FindAll(ctx context.Context) (r entity.UserFields, e err
 	return values, nil
 }
 
+// generated by eevee
+func (d *UserFieldImpl) FindAllWithOption(ctx context.Context, opt *entity.UserFieldFindOption) (r entity.UserFields, e error) {
+	values := entity.UserFields{}
+	if opt == nil {
+		opt = &entity.UserFieldFindOption{}
+	}
+	query := "SELECT `id`, `user_id`, `field_id` FROM `user_fields`"
+	args := []interface{}{}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserFieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserFieldOrderByUserID:
+		orderColumns = []string{"`user_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.UserID, opt.After.ID}
+		}
+	case entity.UserFieldOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort user_fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" WHERE (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.UserField
+		if err := rows.Scan(&value.ID, &value.UserID, &value.FieldID); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *UserFieldImpl) FindByID(ctx context.Context, a0 uint64) (r *entity.UserField, e error) {
 	var value entity.UserField
@@ -184,6 +309,92 @@ func (d *UserFieldImpl) FindByIDs(ctx context.Context, a0 []uint64) (r entity.Us
 	return values, nil
 }
 
+// generated by eevee
+func (d *UserFieldImpl) FindByIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.UserFieldFindOption) (r entity.UserFields, e error) {
+	values := entity.UserFields{}
+	if opt == nil {
+		opt = &entity.UserFieldFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `user_id`, `field_id` FROM `user_fields` WHERE `id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserFieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserFieldOrderByUserID:
+		orderColumns = []string{"`user_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.UserID, opt.After.ID}
+		}
+	case entity.UserFieldOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort user_fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.UserField
+		if err := rows.Scan(
+			&value.ID,
+			&value.UserID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *UserFieldImpl) FindByUserID(ctx context.Context, a0 uint64) (r entity.UserFields, e error) {
 	values := entity.UserFields{}
@@ -228,6 +439,87 @@ func (d *UserFieldImpl) FindByUserIDAndFieldID(ctx context.Context, a0 uint64, a
 	return &value, nil
 }
 
+// generated by eevee
+func (d *UserFieldImpl) FindByUserIDWithOption(ctx context.Context, a0 uint64, opt *entity.UserFieldFindOption) (r entity.UserFields, e error) {
+	values := entity.UserFields{}
+	if opt == nil {
+		opt = &entity.UserFieldFindOption{}
+	}
+	query := "SELECT `id`, `user_id`, `field_id` FROM `user_fields` WHERE `user_id` = ?"
+	args := []interface{}{a0}
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserFieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserFieldOrderByUserID:
+		orderColumns = []string{"`user_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.UserID, opt.After.ID}
+		}
+	case entity.UserFieldOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort user_fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.UserField
+		if err := rows.Scan(
+			&value.ID,
+			&value.UserID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *UserFieldImpl) FindByUserIDs(ctx context.Context, a0 []uint64) (r entity.UserFields, e error) {
 	values := entity.UserFields{}
@@ -262,10 +554,96 @@ func (d *UserFieldImpl) FindByUserIDs(ctx context.Context, a0 []uint64) (r entit
 	return values, nil
 }
 
+// generated by eevee
+func (d *UserFieldImpl) FindByUserIDsWithOption(ctx context.Context, a0 []uint64, opt *entity.UserFieldFindOption) (r entity.UserFields, e error) {
+	values := entity.UserFields{}
+	if opt == nil {
+		opt = &entity.UserFieldFindOption{}
+	}
+	args := []interface{}{}
+	placeholders := make([]string, 0, len(a0))
+	for _, v := range a0 {
+		args = append(args, v)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf("SELECT `id`, `user_id`, `field_id` FROM `user_fields` WHERE `user_id` IN (%s)", strings.Join(placeholders, ", "))
+	var orderColumns []string
+	var cursorValues []interface{}
+	switch opt.OrderBy {
+	case "", entity.UserFieldOrderByID:
+		orderColumns = []string{"`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.ID}
+		}
+	case entity.UserFieldOrderByUserID:
+		orderColumns = []string{"`user_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.UserID, opt.After.ID}
+		}
+	case entity.UserFieldOrderByFieldID:
+		orderColumns = []string{"`field_id`", "`id`"}
+		if opt.After != nil {
+			cursorValues = []interface{}{opt.After.FieldID, opt.After.ID}
+		}
+	default:
+		return nil, xerrors.Errorf("cannot sort user_fields by %s", opt.OrderBy)
+	}
+	direction := "ASC"
+	operator := ">"
+	if opt.Desc {
+		direction = "DESC"
+		operator = "<"
+	}
+	if len(cursorValues) > 0 {
+		placeholders := make([]string, 0, len(cursorValues))
+		for _, v := range cursorValues {
+			args = append(args, v)
+			placeholders = append(placeholders, "?")
+		}
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(orderColumns, ", "), operator, strings.Join(placeholders, ", "))
+	}
+	orders := make([]string, 0, len(orderColumns))
+	for _, column := range orderColumns {
+		orders = append(orders, column+" "+direction)
+	}
+	query += " ORDER BY " + strings.Join(orders, ", ")
+	if opt.Limit > 0 {
+		args = append(args, opt.Limit)
+		query += " LIMIT ?"
+	} else if opt.Offset > 0 {
+		query += " LIMIT 18446744073709551615"
+	}
+	if opt.Offset > 0 {
+		args = append(args, opt.Offset)
+		query += " OFFSET ?"
+	}
+	rows, err := d.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return values, xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			e = xerrors.Errorf("cannot close rows: %w", err)
+		}
+	}()
+	for rows.Next() {
+		var value entity.UserField
+		if err := rows.Scan(
+			&value.ID,
+			&value.UserID,
+			&value.FieldID,
+		); err != nil {
+			return values, xerrors.Errorf("cannot scan value: %w", err)
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
 // generated by eevee
 func (d *UserFieldImpl) Update(ctx context.Context, value *entity.UserField) (e error) {
 	args := []interface{}{value.UserID, value.FieldID, value.ID}
-	query := "UPDATE `user_fields` SET `user_id` = ?, `field_id` = ? WHERE id = ?"
+	query := "UPDATE `user_fields` SET `user_id` = ?, `field_id` = ? WHERE `id` = ?"
 	if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
 		return xerrors.Errorf("failure query %s: %w", query, err)
 	}
@@ -273,12 +651,14 @@ func (d *UserFieldImpl) Update(ctx context.Context, value *entity.UserField) (e
 }
 
 // generated by eevee
-func (d *UserFieldImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserFieldImpl) UpdateByID(ctx context.Context, a0 uint64, updateValue *entity.UserFieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	query := fmt.Sprintf("UPDATE `user_fields` SET %s WHERE `id` = ?", strings.Join(columns, ", "))
@@ -289,12 +669,14 @@ func (d *UserFieldImpl) UpdateByID(ctx context.Context, a0 uint64, updateMap map
 }
 
 // generated by eevee
-func (d *UserFieldImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserFieldImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateValue *entity.UserFieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	for _, v := range a0 {
 		args = append(args, v)
@@ -311,12 +693,14 @@ func (d *UserFieldImpl) UpdateByIDs(ctx context.Context, a0 []uint64, updateMap
 }
 
 // generated by eevee
-func (d *UserFieldImpl) UpdateByUserIDAndFieldID(ctx context.Context, a0 uint64, a1 uint64, updateMap map[string]interface{}) (e error) {
-	columns := []string{}
+func (d *UserFieldImpl) UpdateByUserIDAndFieldID(ctx context.Context, a0 uint64, a1 uint64, updateValue *entity.UserFieldUpdate) (e error) {
+	if updateValue == nil {
+		return nil
+	}
 	args := []interface{}{}
-	for column, v := range updateMap {
-		columns = append(columns, fmt.Sprintf("`%s` = ?", column))
-		args = append(args, v)
+	columns, args := d.updateColumns(updateValue, args)
+	if len(columns) == 0 {
+		return nil
 	}
 	args = append(args, a0)
 	args = append(args, a1)
@@ -326,3 +710,58 @@ func (d *UserFieldImpl) UpdateByUserIDAndFieldID(ctx context.Context, a0 uint64,
 	}
 	return nil
 }
+
+// generated by eevee
+func (d *UserFieldImpl) Upsert(ctx context.Context, value *entity.UserField) (e error) {
+	query := "INSERT INTO `user_fields` (`id`, `user_id`, `field_id`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`), `user_id` = VALUES(`user_id`), `field_id` = VALUES(`field_id`)"
+	result, err := d.tx.ExecContext(ctx, query, value.ID, value.UserID, value.FieldID)
+	if err != nil {
+		return xerrors.Errorf("failure query %s: %w", query, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return xerrors.Errorf("cannot get LastInsertId: %w", err)
+	}
+	value.ID = uint64(id)
+	return nil
+}
+
+// generated by eevee
+func (d *UserFieldImpl) UpsertMulti(ctx context.Context, values entity.UserFields) (e error) {
+	for len(values) > 0 {
+		chunk := values
+		if len(chunk) > 100 {
+			chunk = values[:100]
+		}
+		values = values[len(chunk):]
+		placeholders := make([]string, 0, len(chunk))
+		args := make([]interface{}, 0, len(chunk)*3)
+		for _, value := range chunk {
+			placeholders = append(placeholders, "(?, ?, ?)")
+			args = append(args, value.ID, value.UserID, value.FieldID)
+		}
+		query := fmt.Sprintf("INSERT INTO `user_fields` (`id`, `user_id`, `field_id`) VALUES %s ON DUPLICATE KEY UPDATE `user_id` = VALUES(`user_id`), `field_id` = VALUES(`field_id`)", strings.Join(placeholders, ", "))
+		if _, err := d.tx.ExecContext(ctx, query, args...); err != nil {
+			return xerrors.Errorf("failure query %s: %w", query, err)
+		}
+	}
+	return nil
+}
+
+// generated by eevee
+func (d *UserFieldImpl) updateColumns(updateValue *entity.UserFieldUpdate, args []interface{}) ([]string, []interface{}) {
+	columns := []string{}
+	if updateValue.ID != nil {
+		args = append(args, *updateValue.ID)
+		columns = append(columns, "`id` = ?")
+	}
+	if updateValue.UserID != nil {
+		args = append(args, *updateValue.UserID)
+		columns = append(columns, "`user_id` = ?")
+	}
+	if updateValue.FieldID != nil {
+		args = append(args, *updateValue.FieldID)
+		columns = append(columns, "`field_id` = ?")
+	}
+	return columns, args
+}
@@ -3,18 +3,23 @@ package eevee
 import (
 	"bytes"
 	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"sync"
 
 	"go.knocknote.io/eevee/api"
 	"go.knocknote.io/eevee/class"
+	"go.knocknote.io/eevee/code"
 	"go.knocknote.io/eevee/config"
 	"go.knocknote.io/eevee/dao"
 	"go.knocknote.io/eevee/entity"
 	"go.knocknote.io/eevee/graph"
+	"go.knocknote.io/eevee/migration"
 	"go.knocknote.io/eevee/model"
+	"go.knocknote.io/eevee/output"
 	_ "go.knocknote.io/eevee/plugin"
 	"go.knocknote.io/eevee/repository"
 	"go.knocknote.io/eevee/schema"
@@ -47,61 +52,363 @@ func GenerateByClass(cfg *config.Config, class *types.Class) error {
 }
 
 func GenerateByClasses(cfg *config.Config, classes []*types.Class) error {
-	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
-		return xerrors.Errorf("failed to generate dao package: %w", err)
+	if _, err := generateByClasses(cfg, classes, classes); err != nil {
+		return xerrors.Errorf("failed to generate by classes: %w", err)
+	}
+	return nil
+}
+
+// classGenerator generates files for each class, and files depending on all classes ( e.g. repository.go ) after that.
+type classGenerator struct {
+	name            string
+	generateClass   func(*config.Config, *types.Class) error
+	generatePackage func(*config.Config, []*types.Class) error
+}
+
+// classGenerators are run in this order, because latter generators read files written by former ones ( e.g. repository reads dao ).
+var classGenerators = []*classGenerator{
+	{
+		name: "dao package",
+		generateClass: func(cfg *config.Config, class *types.Class) error {
+			return dao.NewGenerator(cfg).GenerateClass(class)
+		},
+		generatePackage: func(cfg *config.Config, classes []*types.Class) error {
+			return dao.NewGenerator(cfg).GeneratePackage(classes)
+		},
+	},
+	{
+		name: "repository package",
+		generateClass: func(cfg *config.Config, class *types.Class) error {
+			return repository.NewGenerator(cfg).GenerateClass(class)
+		},
+		generatePackage: func(cfg *config.Config, classes []*types.Class) error {
+			return repository.NewGenerator(cfg).GeneratePackage(classes)
+		},
+	},
+	{
+		name: "entity package",
+		generateClass: func(cfg *config.Config, class *types.Class) error {
+			return entity.NewGenerator(cfg).GenerateClass(class)
+		},
+	},
+	{
+		name: "model package",
+		generateClass: func(cfg *config.Config, class *types.Class) error {
+			return model.NewGenerator(cfg).GenerateClass(class)
+		},
+		generatePackage: func(cfg *config.Config, classes []*types.Class) error {
+			return model.NewGenerator(cfg).GeneratePackage(classes)
+		},
+	},
+	{
+		name: "testdata",
+		generateClass: func(cfg *config.Config, class *types.Class) error {
+			return test.NewGenerator(cfg).GenerateClass(class)
+		},
+	},
+}
+
+// runByClasses calls fn for each class concurrently by workers as many as CPUs.
+// if some calls fail, returns the error of the first class in classes to be deterministic.
+func runByClasses(classes []*types.Class, fn func(*types.Class) error) error {
+	errs := make([]error, len(classes))
+	classCh := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range classCh {
+				errs[idx] = fn(classes[idx])
+			}
+		}()
 	}
-	if err := repository.NewGenerator(cfg).Generate(classes); err != nil {
-		return xerrors.Errorf("failed to generate repository package: %w", err)
+	for idx := range classes {
+		classCh <- idx
 	}
-	if err := entity.NewGenerator(cfg).Generate(classes); err != nil {
-		return xerrors.Errorf("failed to generate entity package: %w", err)
+	close(classCh)
+	wg.Wait()
+	for idx, err := range errs {
+		if err != nil {
+			return xerrors.Errorf("failed to generate for %s: %w", classes[idx].Name.SnakeName(), err)
+		}
 	}
-	if err := model.NewGenerator(cfg).Generate(classes); err != nil {
-		return xerrors.Errorf("failed to generate model package: %w", err)
+	return nil
+}
+
+// generateByClasses generates files of targetClasses concurrently and files depending on all classes.
+// each generator uses its own instance for each class, so output doesn't depend on the order of execution.
+// returns manifest of files written for each class in targetClasses.
+func generateByClasses(cfg *config.Config, classes, targetClasses []*types.Class) (map[*types.Class]*output.Manifest, error) {
+	classCfgMap := map[*types.Class]*config.Config{}
+	recorderMap := map[*types.Class]*output.Recorder{}
+	for _, class := range targetClasses {
+		recorder := output.NewRecorder(cfg.OutputWriter())
+		classCfg := *cfg
+		classCfg.Writer = recorder
+		classCfgMap[class] = &classCfg
+		recorderMap[class] = recorder
 	}
-	if err := test.NewGenerator(cfg).Generate(classes); err != nil {
-		return xerrors.Errorf("failed to generate testdata: %w", err)
+	for _, generator := range classGenerators {
+		generateClass := generator.generateClass
+		if err := runByClasses(targetClasses, func(class *types.Class) error {
+			return generateClass(classCfgMap[class], class)
+		}); err != nil {
+			return nil, xerrors.Errorf("failed to generate %s: %w", generator.name, err)
+		}
+		if generator.generatePackage == nil {
+			continue
+		}
+		if err := generator.generatePackage(cfg, classes); err != nil {
+			return nil, xerrors.Errorf("failed to generate %s: %w", generator.name, err)
+		}
 	}
 	if err := api.NewGenerator(cfg).Generate(classes); err != nil {
-		return xerrors.Errorf("failed to generate api: %w", err)
+		return nil, xerrors.Errorf("failed to generate api: %w", err)
 	}
 	if cfg.GraphPath != "" {
 		if err := graph.Generate(cfg, classes); err != nil {
-			return xerrors.Errorf("failed to generate graph: %w", err)
+			return nil, xerrors.Errorf("failed to generate graph: %w", err)
 		}
 	}
-	return nil
+	manifestMap := map[*types.Class]*output.Manifest{}
+	for class, recorder := range recorderMap {
+		manifestMap[class] = recorder.Manifest()
+	}
+	return manifestMap, nil
 }
 
+// Generate writes all files by referring to cfg.
+// written files are recorded to manifest, and generated files that are no longer generated ( e.g. class is removed ) are removed.
 func Generate(cfg *config.Config) error {
+	orphans, err := generateWithManifest(cfg, true)
+	if err != nil {
+		return xerrors.Errorf("failed to generate: %w", err)
+	}
+	for _, path := range orphans.removed {
+		log.Printf("removed %s because it is no longer generated", path)
+	}
+	for _, path := range orphans.kept {
+		log.Printf("%s is no longer generated, but it is kept because it has no generated marker, it has code written by hand or it was changed. remove it if it is unnecessary", path)
+	}
+	return nil
+}
+
+// orphanedFiles files written by the previous time but not written this time
+type orphanedFiles struct {
+	removed []string
+	kept    []string
+}
+
+// generateWithManifest generates all files and removes orphaned files by comparing with manifest written by the previous time.
+// if useCache is true, files of classes whose inputs are not changed since the previous time are not generated again.
+func generateWithManifest(cfg *config.Config, useCache bool) (*orphanedFiles, error) {
+	writer := cfg.OutputWriter()
+	previous, err := output.ReadManifest(writer, output.ManifestFilePath)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read manifest: %w", err)
+	}
+	recorder := output.NewRecorder(writer)
+	generateCfg := *cfg
+	generateCfg.Writer = recorder
+	classes, err := generateClassFiles(&generateCfg)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to generate class files: %w", err)
+	}
+	if !useCache {
+		if _, err := generateByClasses(&generateCfg, classes, classes); err != nil {
+			return nil, xerrors.Errorf("failed to generate by classes: %w", err)
+		}
+	} else {
+		cache, err := generateByClassesWithCache(&generateCfg, classes, previous, recorder.Manifest())
+		if err != nil {
+			return nil, xerrors.Errorf("failed to generate by classes: %w", err)
+		}
+		// cache is not a generated file, so it is written without recording to manifest
+		if err := cache.write(writer, CacheFilePath); err != nil {
+			return nil, xerrors.Errorf("failed to write cache: %w", err)
+		}
+	}
+	orphans, err := removeOrphanedFiles(writer, previous, recorder.Manifest())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to remove orphaned files: %w", err)
+	}
+	return orphans, nil
+}
+
+// generateByClassesWithCache generates files of classes whose inputs are changed or generated files are changed by hand.
+// files of skipped classes are added to manifest as they are. returns cache updated by generated classes.
+func generateByClassesWithCache(cfg *config.Config, classes []*types.Class, previous, manifest *output.Manifest) (*generateCache, error) {
+	writer := cfg.OutputWriter()
+	hashes, err := classInputHashes(cfg, classes)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get hash of inputs: %w", err)
+	}
+	cache := readCache(writer, CacheFilePath)
+	targetClasses := []*types.Class{}
+	newCache := &generateCache{Classes: map[string]*classCache{}}
+	for _, class := range classes {
+		if !cache.isValid(writer, previous, class, hashes[class]) {
+			targetClasses = append(targetClasses, class)
+			continue
+		}
+		classCache := cache.Classes[class.Name.SnakeName()]
+		for _, path := range classCache.Files {
+			manifest.Add(path, previous.Hash(path))
+		}
+		newCache.Classes[class.Name.SnakeName()] = classCache
+	}
+	manifestMap, err := generateByClasses(cfg, classes, targetClasses)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to generate by classes: %w", err)
+	}
+	for _, class := range targetClasses {
+		newCache.Classes[class.Name.SnakeName()] = &classCache{
+			Hash:  hashes[class],
+			Files: manifestMap[class].Paths(),
+		}
+	}
+	return newCache, nil
+}
+
+// removeOrphanedFiles removes files written by the previous time but not written this time.
+// file is removed only if it has generated marker, it has no functions written by hand and it is not changed since it was written.
+// otherwise, it is kept in manifest until it is removed by hand.
+func removeOrphanedFiles(writer output.Writer, previous, manifest *output.Manifest) (*orphanedFiles, error) {
+	orphans := &orphanedFiles{}
+	for _, path := range previous.Paths() {
+		if manifest.Contains(path) || !writer.Exists(path) {
+			continue
+		}
+		content, err := writer.ReadFile(path)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read file %s: %w", path, err)
+		}
+		if bytes.Contains(content, []byte(code.FuncGeneratedMarker)) && !code.HasCustomizedCode(content) && output.Hash(content) == previous.Hash(path) {
+			if err := writer.Remove(path); err != nil {
+				return nil, xerrors.Errorf("cannot remove file %s: %w", path, err)
+			}
+			orphans.removed = append(orphans.removed, path)
+			continue
+		}
+		orphans.kept = append(orphans.kept, path)
+		manifest.Add(path, previous.Hash(path))
+	}
+	if err := writer.WriteFile(output.ManifestFilePath, manifest.Bytes(), 0644); err != nil {
+		return nil, xerrors.Errorf("cannot write manifest to %s: %w", output.ManifestFilePath, err)
+	}
+	return orphans, nil
+}
+
+// generateClassFiles writes schema files by classes and class files by schema, and returns classes read from class files.
+func generateClassFiles(cfg *config.Config) ([]*types.Class, error) {
+	if err := writeSchemaByClass(cfg); err != nil {
+		return nil, xerrors.Errorf("failed to write schema by class: %w", err)
+	}
 	schemata, err := getSchemata(cfg)
 	if err != nil {
-		return xerrors.Errorf("failed to get schemata: %w", err)
+		return nil, xerrors.Errorf("failed to get schemata: %w", err)
 	}
 	writer, err := class.NewWriter(cfg.ClassPath)
 	if err != nil {
-		return xerrors.Errorf("failed to initialize relation writer by %s: %w", cfg.ClassPath, err)
+		return nil, xerrors.Errorf("failed to initialize relation writer by %s: %w", cfg.ClassPath, err)
 	}
-	for _, schema := range schemata {
-		class := schema.ToClass()
+	for _, class := range schema.ToClasses(schemata) {
 		class.DataStore = cfg.DataStore()
 		if err := writer.Write(cfg, class); err != nil {
-			return xerrors.Errorf("failed to write by schema: %w", err)
+			return nil, xerrors.Errorf("failed to write by schema: %w", err)
 		}
 	}
 	reader := class.NewReader()
 	classes, err := reader.ClassByConfig(cfg)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read relation file from %s: %w", cfg.ClassPath, err)
+	}
+	return classes, nil
+}
+
+// Check renders all files into memory instead of writing them, and returns differences from files on disk.
+// class files are read from disk, so classes changed by schema are reflected to code after they are written by `eevee run`.
+func Check(cfg *config.Config) ([]*output.FileDiff, error) {
+	writer := output.NewMemoryWriter()
+	checkCfg := *cfg
+	checkCfg.Writer = writer
+	if _, err := generateWithManifest(&checkCfg, false); err != nil {
+		return nil, xerrors.Errorf("failed to generate: %w", err)
+	}
+	diffs, err := writer.Diff()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get diff: %w", err)
+	}
+	return diffs, nil
+}
+
+// Migrate writes migration files by comparing the current definition of tables with the snapshot of the last migration.
+// if fromSchema is true, definition is read from schema instead of class files.
+// returns nil if there is no difference.
+func Migrate(cfg *config.Config, name string, fromSchema bool) (*migration.Migration, error) {
+	dialect := cfg.SQLDialect()
+	tables := []*migration.Table{}
+	if fromSchema {
+		schemata, err := getSchemata(cfg)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get schemata: %w", err)
+		}
+		for _, schema := range schemata {
+			tables = append(tables, migration.TableFromSchema(dialect, schema))
+		}
+	} else {
+		classes, err := class.NewReader().ClassByConfig(cfg)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read relation file from %s: %w", cfg.ClassPath, err)
+		}
+		for _, class := range classes {
+			if !migration.HasTable(class) {
+				continue
+			}
+			table, err := migration.TableFromClass(dialect, class)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot convert class to table: %w", err)
+			}
+			tables = append(tables, table)
+		}
+	}
+	m, err := migration.NewGenerator(cfg).Generate(tables, name)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to generate migration: %w", err)
+	}
+	return m, nil
+}
+
+// writeSchemaByClass writes CREATE TABLE statements of classes written without schema to schema directory.
+// it is skipped if schema is read from database.
+func writeSchemaByClass(cfg *config.Config) error {
+	if cfg.SchemaPath == "" || cfg.ReadsSchemaFromDatabase() {
+		return nil
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
 	if err != nil {
 		return xerrors.Errorf("failed to read relation file from %s: %w", cfg.ClassPath, err)
 	}
-	if err := GenerateByClasses(cfg, classes); err != nil {
-		return xerrors.Errorf("failed to generate by class: %w", err)
+	schemata, err := getSchemata(cfg)
+	if err != nil {
+		return xerrors.Errorf("failed to get schemata: %w", err)
+	}
+	if err := migration.NewSchemaWriter(cfg).Write(classes, schemata); err != nil {
+		return xerrors.Errorf("failed to write schema: %w", err)
 	}
 	return nil
 }
 
 func getSchemata(cfg *config.Config) ([]*schema.Schema, error) {
-	reader := schema.NewReader()
+	reader := schema.NewReaderWithConfig(cfg)
+	if cfg.ReadsSchemaFromDatabase() {
+		schemata, err := reader.SchemaFromDSN(cfg.SchemaDriver, cfg.SchemaDSN)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read schema from database: %w", err)
+		}
+		return schemata, nil
+	}
 	schemata, err := reader.SchemaFromPath(cfg.SchemaPath)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to read schema files from %s: %w", cfg.SchemaPath, err)
@@ -0,0 +1,177 @@
+package eevee_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"go.knocknote.io/eevee"
+	"go.knocknote.io/eevee/class"
+	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/dao"
+	"go.knocknote.io/eevee/entity"
+	"go.knocknote.io/eevee/model"
+	"go.knocknote.io/eevee/output"
+	"go.knocknote.io/eevee/repository"
+	"go.knocknote.io/eevee/test"
+	"go.knocknote.io/eevee/types"
+)
+
+func TestGenerateByClasses(t *testing.T) {
+	newConfig := func() (*config.Config, *output.MemoryWriter) {
+		writer := output.NewMemoryWriter()
+		return &config.Config{
+			ModulePath: "app",
+			ClassPath:  filepath.Join("repository", "testdata", "class"),
+			OutputPath: filepath.Join("testdata", "generated"),
+			Writer:     writer,
+		}, writer
+	}
+	cfg, serialWriter := newConfig()
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, generator := range []interface {
+		Generate([]*types.Class) error
+	}{
+		dao.NewGenerator(cfg),
+		repository.NewGenerator(cfg),
+		entity.NewGenerator(cfg),
+		model.NewGenerator(cfg),
+		test.NewGenerator(cfg),
+	} {
+		if err := generator.Generate(classes); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	expected, err := serialWriter.Diff()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for i := 0; i < 3; i++ {
+		cfg, parallelWriter := newConfig()
+		if err := eevee.GenerateByClasses(cfg, classes); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		actual, err := parallelWriter.Diff()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if len(actual) != len(expected) {
+			t.Fatalf("expected %d files but got %d", len(expected), len(actual))
+		}
+		for idx := range expected {
+			if !reflect.DeepEqual(actual[idx], expected[idx]) {
+				t.Fatalf("%s is different from serial generation:\n%s", actual[idx].Path, actual[idx].Diff)
+			}
+		}
+	}
+}
+
+// recordingWriter records paths written by generator
+type recordingWriter struct {
+	*output.MemoryWriter
+	written map[string]struct{}
+}
+
+func (w *recordingWriter) WriteFile(path string, content []byte, perm os.FileMode) error {
+	w.written[path] = struct{}{}
+	return w.MemoryWriter.WriteFile(path, content, perm)
+}
+
+func TestGenerateWithCache(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	writeClass := func(name, source string) {
+		if err := ioutil.WriteFile(filepath.Join(classPath, name+".yml"), []byte(source), 0644); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	writeClass("user", `
+name: user
+index:
+  primary_key: id
+members:
+- name: id
+  type: uint64
+- name: name
+  type: string
+`)
+	writeClass("group", `
+name: group
+index:
+  primary_key: id
+members:
+- name: id
+  type: uint64
+`)
+	writer := &recordingWriter{MemoryWriter: output.NewMemoryWriter()}
+	cfg := &config.Config{
+		ModulePath: "app",
+		ClassPath:  classPath,
+		OutputPath: filepath.Join("testdata", "generated"),
+		Writer:     writer,
+	}
+	userPath := filepath.Join(cfg.OutputPath, "dao", "user.go")
+	groupPath := filepath.Join(cfg.OutputPath, "dao", "group.go")
+	generate := func() map[string]struct{} {
+		writer.written = map[string]struct{}{}
+		if err := eevee.Generate(cfg); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return writer.written
+	}
+	for _, test := range []struct {
+		desc     string
+		change   func()
+		expected map[string]bool
+	}{
+		{
+			desc:     "first generation",
+			change:   func() {},
+			expected: map[string]bool{userPath: true, groupPath: true},
+		},
+		{
+			desc:     "nothing is changed",
+			change:   func() {},
+			expected: map[string]bool{userPath: false, groupPath: false},
+		},
+		{
+			desc: "class is changed",
+			change: func() {
+				writeClass("group", `
+name: group
+index:
+  primary_key: id
+members:
+- name: id
+  type: uint64
+- name: name
+  type: string
+`)
+			},
+			expected: map[string]bool{userPath: false, groupPath: true},
+		},
+		{
+			desc: "config is changed",
+			change: func() {
+				cfg.Renderer = &config.Renderer{Style: config.RenderStyleLowerSnake}
+			},
+			expected: map[string]bool{userPath: true, groupPath: true},
+		},
+	} {
+		test.change()
+		written := generate()
+		for path, expected := range test.expected {
+			if _, exists := written[path]; exists != expected {
+				t.Fatalf("%s: unexpected generation of %s: %v", test.desc, path, exists)
+			}
+		}
+	}
+}
@@ -2,8 +2,6 @@ package entity
 
 import (
 	"fmt"
-	"io/ioutil"
-	"os"
 	"path/filepath"
 
 	. "go.knocknote.io/eevee/code"
@@ -79,6 +77,255 @@ func (g *Generator) structCodes(class *types.Class) []Code {
 	return codes
 }
 
+// updateStructCodes returns fields of entity to specify updated columns.
+// each field is pointer to distinguish columns to be updated from zero value.
+func (g *Generator) updateStructCodes(class *types.Class) []Code {
+	codes := []Code{}
+	for _, member := range class.UpdateColumnMembers() {
+		codes = append(codes, Id(member.Name.CamelName()).Op("*").Add(member.Type.CodePackage(g.packageName, g.importList)))
+	}
+	return codes
+}
+
+// enumCodes generate named type for enum member like the following code
+// ===========================================================
+// type Sex string
+//
+// const (
+//	SexMan   Sex = "man"
+//	SexWoman Sex = "woman"
+// )
+// ===========================================================
+func (g *Generator) enumCodes(f *File, member *types.Member) {
+	typeName := member.Type.Name()
+	TypeDef(f, typeName, String())
+	constants := []Code{}
+	for _, constant := range member.EnumConstants() {
+		constants = append(constants, Id(constant.Name).Id(typeName).Op("=").Lit(constant.Value))
+	}
+	f.Line()
+	f.Add(Const().Defs(constants...))
+	for _, mtd := range []*types.Method{
+		g.enumIsValidMethod(member),
+		g.enumStringMethod(member),
+		g.enumMarshalJSONMethod(member),
+		g.enumUnmarshalJSONMethod(member),
+		g.enumScanMethod(member),
+		g.enumValueMethod(member),
+	} {
+		g.addMethod(f, mtd)
+	}
+}
+
+// findOptionCodes generate types to specify order and range of records found by WithOption methods like the following code
+// ===========================================================
+// type UserOrderColumn string
+//
+// const (
+//	UserOrderByID   UserOrderColumn = "id"
+//	UserOrderByName UserOrderColumn = "name"
+// )
+//
+// type UserFindOption struct {
+//	OrderBy UserOrderColumn
+//	Desc    bool
+//	Limit   int
+//	Offset  int
+//	After   *User
+// }
+// ===========================================================
+func (g *Generator) findOptionCodes(f *File, class *types.Class, orderMembers types.Members) {
+	typeName := class.OrderColumnTypeName()
+	f.Line()
+	f.Comment(fmt.Sprintf("%s specifies indexed column to sort records found by WithOption methods.", typeName))
+	f.Add(GoType().Id(typeName).String())
+	constants := []Code{}
+	for _, member := range orderMembers {
+		constants = append(constants, Id(class.OrderColumnName(member)).Id(typeName).Op("=").Lit(member.Name.SnakeName()))
+	}
+	f.Line()
+	f.Add(Const().Defs(constants...))
+	f.Line()
+	f.Comment(fmt.Sprintf("%s specifies order and range of records found by WithOption methods.", class.FindOptionStructName()))
+	f.Add(GoType().Id(class.FindOptionStructName()).Struct(
+		Comment("OrderBy column to sort records. records are sorted by primary key if it is empty"),
+		Id("OrderBy").Id(typeName),
+		Id("Desc").Bool(),
+		Comment("Limit max number of records. all records are found if it is zero"),
+		Id("Limit").Int(),
+		Id("Offset").Int(),
+		Comment("After cursor for keyset pagination. records after it in the order are found ( e.g. last record of previous page )"),
+		Id("After").Op("*").Id(class.Name.CamelName()),
+	))
+}
+
+func (g *Generator) enumMethodDeclare(member *types.Member, methodName string) *types.MethodDeclare {
+	return &types.MethodDeclare{
+		ReceiverName:         g.receiverName,
+		ReceiverClassName:    member.Type.Name(),
+		MethodName:           methodName,
+		IsNotPointerReceiver: true,
+		Args:                 types.ValueDeclares{},
+		Return:               types.ValueDeclares{},
+	}
+}
+
+func (g *Generator) invalidEnumError(member *types.Member, value Code) Code {
+	return Qual(g.importList.Package("xerrors"), "Errorf").Call(
+		Lit(fmt.Sprintf("invalid %s value %%q", member.Type.Name())),
+		value,
+	)
+}
+
+func (g *Generator) enumIsValidMethod(member *types.Member) *types.Method {
+	decl := g.enumMethodDeclare(member, "IsValid")
+	decl.Return = append(decl.Return, &types.ValueDeclare{
+		Type: types.TypeDeclareWithType(types.BoolType),
+	})
+	cases := []Code{}
+	for _, constant := range member.EnumConstants() {
+		cases = append(cases, Id(constant.Name))
+	}
+	return &types.Method{
+		Decl: decl,
+		Body: []Code{
+			Switch(Id(g.receiverName)).Block(
+				Case(cases...).Block(Return(True())),
+			),
+			Return(False()),
+		},
+	}
+}
+
+func (g *Generator) enumStringMethod(member *types.Member) *types.Method {
+	decl := g.enumMethodDeclare(member, "String")
+	decl.Return = append(decl.Return, &types.ValueDeclare{
+		Type: types.TypeDeclareWithType(types.StringType),
+	})
+	return &types.Method{
+		Decl: decl,
+		Body: []Code{
+			Return(String().Call(Id(g.receiverName))),
+		},
+	}
+}
+
+func (g *Generator) enumMarshalJSONMethod(member *types.Member) *types.Method {
+	decl := g.enumMethodDeclare(member, "MarshalJSON")
+	decl.Return = append(decl.Return,
+		&types.ValueDeclare{Type: types.TypeDeclareWithName("[]byte")},
+		&types.ValueDeclare{Type: types.TypeDeclareWithType(types.ErrorType)},
+	)
+	return &types.Method{
+		Decl: decl,
+		Body: []Code{
+			If(Op("!").Id(g.receiverName).Dot("IsValid").Call()).Block(
+				Return(Nil(), g.invalidEnumError(member, String().Call(Id(g.receiverName)))),
+			),
+			Return(Qual(g.importList.Package("json"), "Marshal").Call(String().Call(Id(g.receiverName)))),
+		},
+	}
+}
+
+func (g *Generator) enumUnmarshalJSONMethod(member *types.Member) *types.Method {
+	decl := g.enumMethodDeclare(member, "UnmarshalJSON")
+	decl.IsNotPointerReceiver = false
+	decl.Args = append(decl.Args, &types.ValueDeclare{
+		Name: "bytes",
+		Type: types.TypeDeclareWithName("[]byte"),
+	})
+	decl.Return = append(decl.Return, &types.ValueDeclare{
+		Type: types.TypeDeclareWithType(types.ErrorType),
+	})
+	typeName := member.Type.Name()
+	return &types.Method{
+		Decl: decl,
+		Body: []Code{
+			Var().Id("value").String(),
+			If(
+				Err().Op(":=").Qual(g.importList.Package("json"), "Unmarshal").Call(Id("bytes"), Op("&").Id("value")),
+				Err().Op("!=").Nil(),
+			).Block(
+				Return(Qual(g.importList.Package("xerrors"), "Errorf").Call(
+					Lit(fmt.Sprintf("failed to unmarshal %s: %%w", typeName)),
+					Err(),
+				)),
+			),
+			If(Op("!").Id(typeName).Call(Id("value")).Dot("IsValid").Call()).Block(
+				Return(g.invalidEnumError(member, Id("value"))),
+			),
+			Op("*").Id(g.receiverName).Op("=").Id(typeName).Call(Id("value")),
+			Return(Nil()),
+		},
+	}
+}
+
+func (g *Generator) enumScanMethod(member *types.Member) *types.Method {
+	decl := g.enumMethodDeclare(member, "Scan")
+	decl.IsNotPointerReceiver = false
+	decl.Args = append(decl.Args, &types.ValueDeclare{
+		Name: "src",
+		Type: types.TypeDeclareWithName("interface{}"),
+	})
+	decl.Return = append(decl.Return, &types.ValueDeclare{
+		Type: types.TypeDeclareWithType(types.ErrorType),
+	})
+	typeName := member.Type.Name()
+	return &types.Method{
+		Decl: decl,
+		Body: []Code{
+			Var().Id("value").Id(typeName),
+			Switch(Id("v").Op(":=").Id("src").Assert(GoType())).Block(
+				Case(Nil()).Block(
+					Op("*").Id(g.receiverName).Op("=").Id("value"),
+					Return(Nil()),
+				),
+				Case(String()).Block(
+					Id("value").Op("=").Id(typeName).Call(Id("v")),
+				),
+				Case(Index().Byte()).Block(
+					Id("value").Op("=").Id(typeName).Call(Id("v")),
+				),
+				Default().Block(
+					Return(Qual(g.importList.Package("xerrors"), "Errorf").Call(
+						Lit(fmt.Sprintf("cannot scan %%T into %s", typeName)),
+						Id("src"),
+					)),
+				),
+			),
+			If(Op("!").Id("value").Dot("IsValid").Call()).Block(
+				Return(g.invalidEnumError(member, String().Call(Id("value")))),
+			),
+			Op("*").Id(g.receiverName).Op("=").Id("value"),
+			Return(Nil()),
+		},
+	}
+}
+
+func (g *Generator) enumValueMethod(member *types.Member) *types.Method {
+	decl := g.enumMethodDeclare(member, "Value")
+	decl.Return = append(decl.Return,
+		&types.ValueDeclare{
+			Type: &types.TypeDeclare{
+				Type: &types.Type{
+					ImportPath: "database/sql/driver",
+					Name:       "Value",
+				},
+			},
+		},
+		&types.ValueDeclare{Type: types.TypeDeclareWithType(types.ErrorType)},
+	)
+	return &types.Method{
+		Decl: decl,
+		Body: []Code{
+			If(Op("!").Id(g.receiverName).Dot("IsValid").Call()).Block(
+				Return(Nil(), g.invalidEnumError(member, String().Call(Id(g.receiverName)))),
+			),
+			Return(String().Call(Id(g.receiverName)), Nil()),
+		},
+	}
+}
+
 func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 	for _, pluginName := range g.cfg.EntityPlugins() {
 		plg, ok := Plugin(pluginName)
@@ -87,11 +334,25 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 		}
 		g.importList = plg.Imports(g.importList)
 	}
-	f := NewGeneratedFile(g.packageName, g.importList)
+	f := NewFile(g.packageName)
+	for _, importDeclare := range g.importList {
+		f.ImportName(importDeclare.Path, importDeclare.Name)
+	}
 	entityName := class.Name.CamelName()
 	sliceName := class.Name.PluralCamelName()
 	AddStruct(f, entityName, g.structCodes(class))
 	TypeDef(f, sliceName, Index().Op("*").Id(entityName))
+	if !class.ReadOnly && !g.cfg.UseUpdateMap() {
+		f.Line()
+		f.Comment(fmt.Sprintf("%s specifies columns updated by UpdateBy methods. nil fields are not updated.", class.UpdateStructName()))
+		f.Add(GoType().Id(class.UpdateStructName()).Struct(g.updateStructCodes(class)...))
+	}
+	if orderMembers := class.OrderMembers(); len(orderMembers) > 0 {
+		g.findOptionCodes(f, class, orderMembers)
+	}
+	for _, member := range class.EnumMembers() {
+		g.enumCodes(f, member)
+	}
 	for _, member := range class.Members {
 		if member.Extend {
 			continue
@@ -116,36 +377,47 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 }
 
 func (g *Generator) existsFile(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
+	return g.cfg.OutputWriter().Exists(path)
 }
 
 func (g *Generator) writeFile(class *types.Class, basePath string, source []byte) error {
 	path := filepath.Join(basePath, fmt.Sprintf("%s.go", class.Name.SnakeName()))
 	if g.existsFile(path) {
-		if err := os.Remove(path); err != nil {
+		if err := g.cfg.OutputWriter().Remove(path); err != nil {
 			return xerrors.Errorf("failed to remove file %s: %w", path, err)
 		}
 	}
-	if err := ioutil.WriteFile(path, source, 0444); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(path, source, 0644); err != nil {
 		return xerrors.Errorf("cannot write file to %s: %w", path, err)
 	}
 	return nil
 }
 
-func (g *Generator) Generate(classes []*types.Class) error {
+// GenerateClass generates entity for class
+func (g *Generator) GenerateClass(class *types.Class) error {
 	path := g.cfg.OutputPathWithPackage(g.packageName)
-	if err := os.MkdirAll(path, 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(path); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", path, err)
 	}
+	source, err := g.generate(class, path)
+	if err != nil {
+		return xerrors.Errorf("cannot generate entity for %s: %w", class.Name.SnakeName(), err)
+	}
+	source, err = MergeCustomizedFile(g.cfg.OutputWriter(), filepath.Join(path, fmt.Sprintf("%s.go", class.Name.SnakeName())), source)
+	if err != nil {
+		return xerrors.Errorf("cannot merge entity for %s: %w", class.Name.SnakeName(), err)
+	}
+	if err := g.writeFile(class, path, source); err != nil {
+		return xerrors.Errorf("cannot write file to %s for %s: %w", path, class.Name.SnakeName(), err)
+	}
+	return nil
+}
+
+func (g *Generator) Generate(classes []*types.Class) error {
 	for _, class := range classes {
-		source, err := g.generate(class, path)
-		if err != nil {
+		if err := g.GenerateClass(class); err != nil {
 			return xerrors.Errorf("cannot generate entity for %s: %w", class.Name.SnakeName(), err)
 		}
-		if err := g.writeFile(class, path, source); err != nil {
-			return xerrors.Errorf("cannot write file to %s for %s: %w", path, class.Name.SnakeName(), err)
-		}
 	}
 	return nil
 }
@@ -1,12 +1,17 @@
 package entity_test
 
 import (
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"go.knocknote.io/eevee/class"
+	"go.knocknote.io/eevee/code"
 	"go.knocknote.io/eevee/config"
 	"go.knocknote.io/eevee/entity"
+	"go.knocknote.io/eevee/output"
 	_ "go.knocknote.io/eevee/plugin"
 )
 
@@ -23,3 +28,123 @@ func TestGenerate(t *testing.T) {
 		t.Fatalf("%+v", err)
 	}
 }
+
+func TestGenerateWithCustomizedCode(t *testing.T) {
+	writer := output.NewMemoryWriter()
+	cfg := &config.Config{
+		OutputPath: filepath.Join("testdata"),
+		ClassPath:  filepath.Join("testdata", "class"),
+		Writer:     writer,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	path := filepath.Join("testdata", "entity", "group.go")
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	customizedCodes := []string{
+		"// GroupKind kind of group\ntype GroupKind int",
+		"const (\n\tGroupKindPublic GroupKind = iota\n\tGroupKindPrivate\n)",
+		"// DefaultGroupName is used if name is empty\nvar DefaultGroupName = \"guest\"",
+		"// TODO: add kind member to class file",
+		"func (e *Group) IsDefault() bool {\n\treturn e.Name == DefaultGroupName\n}",
+	}
+	customized := string(content) + "\n" + strings.Join(customizedCodes, "\n\n") + "\n"
+	if err := writer.WriteFile(path, []byte(customized), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// generate twice to check that customized code is not duplicated
+	for i := 0; i < 2; i++ {
+		if err := entity.NewGenerator(cfg).Generate(classes); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	generated, err := writer.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source := string(generated)
+	for _, code := range append(customizedCodes, "type Groups []*Group") {
+		if strings.Count(source, code) != 1 {
+			t.Fatalf("expected %s is kept only once:\n%s", code, source)
+		}
+	}
+}
+
+func TestGenerateWithRemovedEnum(t *testing.T) {
+	classPath, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(classPath)
+	writer := output.NewMemoryWriter()
+	cfg := &config.Config{
+		OutputPath: "testdata",
+		ClassPath:  classPath,
+		Writer:     writer,
+	}
+	path := filepath.Join("testdata", "entity", "user.go")
+	customizedCode := "// MaxAge is max value of age\nconst MaxAge = 100"
+	for _, source := range []string{`
+name: user
+members:
+- name: id
+  type: uint64
+- name: sex
+  type: string
+  enum:
+  - man
+  - woman
+index:
+  primary_key: id
+`, `
+name: user
+members:
+- name: id
+  type: uint64
+- name: sex
+  type: string
+index:
+  primary_key: id
+`} {
+		if err := ioutil.WriteFile(filepath.Join(classPath, "user.yml"), []byte(source), 0644); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		classes, err := class.NewReader().ClassByConfig(cfg)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if err := entity.NewGenerator(cfg).Generate(classes); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		generated, err := writer.ReadFile(path)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if !strings.Contains(string(generated), customizedCode) {
+			customized := string(generated) + "\n" + customizedCode + "\n"
+			if err := writer.WriteFile(path, []byte(customized), 0644); err != nil {
+				t.Fatalf("%+v", err)
+			}
+		}
+	}
+	generated, err := writer.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source := string(generated)
+	if !strings.HasPrefix(source, "// "+code.MergedFileMarker+"\n") {
+		t.Fatalf("expected file has generated marker:\n%s", source)
+	}
+	for _, code := range []string{"type Sex string", "SexMan", "func (e Sex) IsValid() bool"} {
+		if strings.Contains(source, code) {
+			t.Fatalf("expected %s is removed with enum:\n%s", code, source)
+		}
+	}
+	if strings.Count(source, customizedCode) != 1 {
+		t.Fatalf("expected %s is kept only once:\n%s", customizedCode, source)
+	}
+}
@@ -6,11 +6,15 @@ require (
 	github.com/blastrain/vitess-sqlparser v0.0.0-20200914074247-af18b79da035
 	github.com/dave/jennifer v1.3.0
 	github.com/fsnotify/fsnotify v1.4.7 // indirect
+	github.com/go-sql-driver/mysql v1.5.0
 	github.com/goccy/go-yaml v1.4.0
 	github.com/iancoleman/strcase v0.0.0-20190422225806-e506e3ef7365
 	github.com/jessevdk/go-flags v1.4.0
 	github.com/jinzhu/inflection v1.0.0
 	github.com/juju/errors v0.0.0-20190207033735-e65537c515d7 // indirect
+	github.com/lib/pq v1.3.0
+	github.com/mattn/go-sqlite3 v1.14.6
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/rakyll/statik v0.1.6
 	golang.org/x/tools v0.0.0-20190606124116-d0a3d012864b
 	golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898
@@ -3,7 +3,6 @@ package graph
 import (
 	"bytes"
 	"io/ioutil"
-	"os"
 	"path/filepath"
 	"text/template"
 
@@ -107,11 +106,11 @@ func Generate(cfg *config.Config, classes []*types.Class) error {
 	}); err != nil {
 		return xerrors.Errorf("failed to execute template: %w", err)
 	}
-	if err := os.MkdirAll(cfg.GraphPath, 0755); err != nil {
+	if err := cfg.OutputWriter().MkdirAll(cfg.GraphPath); err != nil {
 		return xerrors.Errorf("failed to create directory %s: %w", cfg.GraphPath, err)
 	}
 	graphPath := filepath.Join(cfg.GraphPath, "index.html")
-	if err := ioutil.WriteFile(graphPath, buf.Bytes(), 0644); err != nil {
+	if err := cfg.OutputWriter().WriteFile(graphPath, buf.Bytes(), 0644); err != nil {
 		return xerrors.Errorf("failed to write index.html to %s: %w", graphPath, err)
 	}
 	vizFile, err := statikFS.Open("/viz.js")
@@ -123,7 +122,7 @@ func Generate(cfg *config.Config, classes []*types.Class) error {
 		return xerrors.Errorf("failed to read from viz.js: %w", err)
 	}
 	vizPath := filepath.Join(cfg.GraphPath, "viz.js")
-	if err := ioutil.WriteFile(vizPath, vizBytes, 0644); err != nil {
+	if err := cfg.OutputWriter().WriteFile(vizPath, vizBytes, 0644); err != nil {
 		return xerrors.Errorf("failed to write viz.js to %s: %w", vizPath, err)
 	}
 	return nil
@@ -0,0 +1,202 @@
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"go.knocknote.io/eevee/types"
+	"golang.org/x/xerrors"
+)
+
+// ddlBuilder builds DDL statements for the dialect
+type ddlBuilder struct {
+	dialect types.Dialect
+}
+
+func (b *ddlBuilder) quote(name string) string {
+	return b.dialect.Quote(name)
+}
+
+func (b *ddlBuilder) quoteColumns(columns []string) string {
+	quoted := []string{}
+	for _, column := range columns {
+		quoted = append(quoted, b.quote(column))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// checkConstraintName returns name of CHECK constraint declared in column definition.
+// PostgreSQL names it by <table>_<column>_check.
+func (b *ddlBuilder) checkConstraintName(tableName string, column *Column) string {
+	return fmt.Sprintf("%s_%s_check", tableName, column.Name)
+}
+
+func (b *ddlBuilder) checkEnum(column *Column) string {
+	values := []string{}
+	for _, value := range column.Enum {
+		values = append(values, quoteString(value))
+	}
+	return fmt.Sprintf("CHECK (%s IN (%s))", b.quote(column.Name), strings.Join(values, ", "))
+}
+
+// columnDefinition returns definition of column.
+// MySQL expresses enum values by column type, and other dialects express them by CHECK constraint.
+func (b *ddlBuilder) columnDefinition(column *Column) string {
+	definition := fmt.Sprintf("%s %s", b.quote(column.Name), column.Type)
+	switch {
+	case !column.Nullable:
+		definition += " NOT NULL"
+	case b.dialect == types.DialectMySQL:
+		// schema reader of MySQL treats column without NULL as NOT NULL
+		definition += " NULL"
+	}
+	if column.AutoIncrement {
+		switch b.dialect {
+		case types.DialectPostgres:
+			definition += " GENERATED BY DEFAULT AS IDENTITY"
+		case types.DialectMySQL:
+			definition += " AUTO_INCREMENT"
+		}
+	}
+	if column.Default != "" {
+		definition += " DEFAULT " + column.Default
+	}
+	if len(column.Enum) > 0 && b.dialect != types.DialectMySQL {
+		definition += " " + b.checkEnum(column)
+	}
+	return definition
+}
+
+// createTable returns CREATE TABLE statement and CREATE INDEX statements.
+// MySQL declares indexes in CREATE TABLE statement.
+func (b *ddlBuilder) createTable(table *Table) []string {
+	definitions := []string{}
+	for _, column := range table.Columns {
+		definitions = append(definitions, b.columnDefinition(column))
+	}
+	if len(table.PrimaryKey) > 0 {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", b.quoteColumns(table.PrimaryKey)))
+	}
+	if b.dialect == types.DialectMySQL {
+		for _, key := range table.UniqueKeys {
+			definitions = append(definitions, fmt.Sprintf("UNIQUE KEY %s (%s)", b.quote(key.Name), b.quoteColumns(key.Columns)))
+		}
+		for _, key := range table.Keys {
+			definitions = append(definitions, fmt.Sprintf("KEY %s (%s)", b.quote(key.Name), b.quoteColumns(key.Columns)))
+		}
+	}
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n  %s\n)", b.quote(table.Name), strings.Join(definitions, ",\n  ")),
+	}
+	if b.dialect == types.DialectMySQL {
+		return statements
+	}
+	for _, key := range table.UniqueKeys {
+		statements = append(statements, b.createIndex(table, key, true))
+	}
+	for _, key := range table.Keys {
+		statements = append(statements, b.createIndex(table, key, false))
+	}
+	return statements
+}
+
+func (b *ddlBuilder) dropTable(table *Table) string {
+	return fmt.Sprintf("DROP TABLE %s", b.quote(table.Name))
+}
+
+// addColumn returns ALTER TABLE ... ADD COLUMN. MySQL keeps the order of columns by AFTER clause.
+func (b *ddlBuilder) addColumn(table *Table, column *Column, after string) string {
+	statement := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", b.quote(table.Name), b.columnDefinition(column))
+	if b.dialect != types.DialectMySQL {
+		return statement
+	}
+	if after == "" {
+		return statement + " FIRST"
+	}
+	return statement + " AFTER " + b.quote(after)
+}
+
+func (b *ddlBuilder) dropColumn(table *Table, column *Column) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", b.quote(table.Name), b.quote(column.Name))
+}
+
+// modifyColumn returns statements to change definition of column from oldColumn to newColumn.
+// SQLite cannot change definition of column by ALTER TABLE, so it returns error.
+func (b *ddlBuilder) modifyColumn(table *Table, oldColumn, newColumn *Column) ([]string, error) {
+	tableName := b.quote(table.Name)
+	switch b.dialect {
+	case types.DialectMySQL:
+		return []string{fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s", tableName, b.columnDefinition(newColumn))}, nil
+	case types.DialectSQLite:
+		return nil, xerrors.Errorf("sqlite cannot modify column %s of %s by ALTER TABLE", newColumn.Name, table.Name)
+	}
+	alterColumn := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", tableName, b.quote(newColumn.Name))
+	statements := []string{}
+	if oldColumn.Type != newColumn.Type {
+		statements = append(statements, fmt.Sprintf("%s TYPE %s", alterColumn, newColumn.Type))
+	}
+	if oldColumn.Nullable != newColumn.Nullable {
+		if newColumn.Nullable {
+			statements = append(statements, alterColumn+" DROP NOT NULL")
+		} else {
+			statements = append(statements, alterColumn+" SET NOT NULL")
+		}
+	}
+	if oldColumn.AutoIncrement != newColumn.AutoIncrement {
+		if newColumn.AutoIncrement {
+			statements = append(statements, alterColumn+" ADD GENERATED BY DEFAULT AS IDENTITY")
+		} else {
+			statements = append(statements, alterColumn+" DROP IDENTITY")
+		}
+	}
+	if oldColumn.Default != newColumn.Default {
+		if newColumn.Default == "" {
+			statements = append(statements, alterColumn+" DROP DEFAULT")
+		} else {
+			statements = append(statements, fmt.Sprintf("%s SET DEFAULT %s", alterColumn, newColumn.Default))
+		}
+	}
+	if strings.Join(oldColumn.Enum, ",") != strings.Join(newColumn.Enum, ",") {
+		constraintName := b.quote(b.checkConstraintName(table.Name, newColumn))
+		if len(oldColumn.Enum) > 0 {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", tableName, constraintName))
+		}
+		if len(newColumn.Enum) > 0 {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s", tableName, constraintName, b.checkEnum(newColumn)))
+		}
+	}
+	return statements, nil
+}
+
+func (b *ddlBuilder) dropPrimaryKey(table *Table) (string, error) {
+	switch b.dialect {
+	case types.DialectMySQL:
+		return fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", b.quote(table.Name)), nil
+	case types.DialectPostgres:
+		return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", b.quote(table.Name), b.quote(table.Name+"_pkey")), nil
+	}
+	return "", xerrors.Errorf("sqlite cannot change primary key of %s by ALTER TABLE", table.Name)
+}
+
+func (b *ddlBuilder) addPrimaryKey(table *Table) (string, error) {
+	if b.dialect == types.DialectSQLite {
+		return "", xerrors.Errorf("sqlite cannot change primary key of %s by ALTER TABLE", table.Name)
+	}
+	return fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)", b.quote(table.Name), b.quoteColumns(table.PrimaryKey)), nil
+}
+
+func (b *ddlBuilder) createIndex(table *Table, index *Index, isUnique bool) string {
+	createIndex := "CREATE INDEX"
+	if isUnique {
+		createIndex = "CREATE UNIQUE INDEX"
+	}
+	return fmt.Sprintf("%s %s ON %s (%s)", createIndex, b.quote(index.Name), b.quote(table.Name), b.quoteColumns(index.Columns))
+}
+
+// dropIndex returns DROP INDEX. index name of MySQL is unique in table, so it requires table name.
+func (b *ddlBuilder) dropIndex(table *Table, index *Index) string {
+	if b.dialect == types.DialectMySQL {
+		return fmt.Sprintf("DROP INDEX %s ON %s", b.quote(index.Name), b.quote(table.Name))
+	}
+	return fmt.Sprintf("DROP INDEX %s", b.quote(index.Name))
+}
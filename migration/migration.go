@@ -0,0 +1,257 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/types"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// SnapshotFileName file name of snapshot in migration directory.
+	// snapshot keeps definition of tables at the time of the last migration.
+	SnapshotFileName = "snapshot.json"
+	DefaultName      = "migration"
+	versionFormat    = "20060102150405"
+)
+
+// Migration statements to migrate from snapshot to current definition ( Up ) and to revert it ( Down )
+type Migration struct {
+	Version string
+	Name    string
+	Up      []string
+	Down    []string
+}
+
+func (m *Migration) fileName(direction string) string {
+	return fmt.Sprintf("%s_%s.%s.sql", m.Version, m.Name, direction)
+}
+
+// UpFileName returns file name in the format of golang-migrate ( <version>_<name>.up.sql )
+func (m *Migration) UpFileName() string {
+	return m.fileName("up")
+}
+
+// DownFileName returns file name in the format of golang-migrate ( <version>_<name>.down.sql )
+func (m *Migration) DownFileName() string {
+	return m.fileName("down")
+}
+
+func joinStatements(statements []string) string {
+	return strings.Join(statements, ";\n\n") + ";\n"
+}
+
+// Diff returns statements to migrate tables from oldTables to newTables.
+// tables and columns are identified by name, so renaming is expressed by dropping and adding.
+func Diff(dialect types.Dialect, oldTables, newTables []*Table) ([]string, error) {
+	b := &ddlBuilder{dialect: dialect}
+	oldTableMap := map[string]*Table{}
+	for _, table := range oldTables {
+		oldTableMap[table.Name] = table
+	}
+	newTableMap := map[string]*Table{}
+	for _, table := range newTables {
+		newTableMap[table.Name] = table
+	}
+	statements := []string{}
+	for _, table := range newTables {
+		oldTable, exists := oldTableMap[table.Name]
+		if !exists {
+			statements = append(statements, b.createTable(table)...)
+			continue
+		}
+		alterStatements, err := diffTable(b, oldTable, table)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to migrate %s: %w", table.Name, err)
+		}
+		statements = append(statements, alterStatements...)
+	}
+	for idx := len(oldTables) - 1; idx >= 0; idx-- {
+		if _, exists := newTableMap[oldTables[idx].Name]; !exists {
+			statements = append(statements, b.dropTable(oldTables[idx]))
+		}
+	}
+	return statements, nil
+}
+
+// diffIndexes returns indexes contained only in indexes
+func diffIndexes(indexes, otherIndexes []*Index) []*Index {
+	otherIndexMap := map[string]struct{}{}
+	for _, index := range otherIndexes {
+		otherIndexMap[index.key()] = struct{}{}
+	}
+	diff := []*Index{}
+	for _, index := range indexes {
+		if _, exists := otherIndexMap[index.key()]; !exists {
+			diff = append(diff, index)
+		}
+	}
+	return diff
+}
+
+// diffTable returns statements to alter oldTable to newTable.
+// indexes are dropped before changing columns and created after that, because they may contain changed columns.
+func diffTable(b *ddlBuilder, oldTable, newTable *Table) ([]string, error) {
+	statements := []string{}
+	for _, key := range diffIndexes(oldTable.UniqueKeys, newTable.UniqueKeys) {
+		statements = append(statements, b.dropIndex(oldTable, key))
+	}
+	for _, key := range diffIndexes(oldTable.Keys, newTable.Keys) {
+		statements = append(statements, b.dropIndex(oldTable, key))
+	}
+	isChangedPrimaryKey := strings.Join(oldTable.PrimaryKey, ",") != strings.Join(newTable.PrimaryKey, ",")
+	if isChangedPrimaryKey && len(oldTable.PrimaryKey) > 0 {
+		statement, err := b.dropPrimaryKey(oldTable)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to drop primary key: %w", err)
+		}
+		statements = append(statements, statement)
+	}
+	for _, column := range oldTable.Columns {
+		if newTable.column(column.Name) == nil {
+			statements = append(statements, b.dropColumn(oldTable, column))
+		}
+	}
+	after := ""
+	for _, column := range newTable.Columns {
+		oldColumn := oldTable.column(column.Name)
+		switch {
+		case oldColumn == nil:
+			statements = append(statements, b.addColumn(newTable, column, after))
+		case !oldColumn.equals(column):
+			modifyStatements, err := b.modifyColumn(newTable, oldColumn, column)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to modify column: %w", err)
+			}
+			statements = append(statements, modifyStatements...)
+		}
+		after = column.Name
+	}
+	if isChangedPrimaryKey && len(newTable.PrimaryKey) > 0 {
+		statement, err := b.addPrimaryKey(newTable)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to add primary key: %w", err)
+		}
+		statements = append(statements, statement)
+	}
+	for _, key := range diffIndexes(newTable.UniqueKeys, oldTable.UniqueKeys) {
+		statements = append(statements, b.createIndex(newTable, key, true))
+	}
+	for _, key := range diffIndexes(newTable.Keys, oldTable.Keys) {
+		statements = append(statements, b.createIndex(newTable, key, false))
+	}
+	return statements, nil
+}
+
+// ReadSnapshot reads definition of tables from snapshot file. if snapshot doesn't exist, returns empty tables.
+func ReadSnapshot(path string) ([]*Table, error) {
+	if _, err := os.Stat(path); err != nil {
+		return []*Table{}, nil
+	}
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read snapshot file: %w", err)
+	}
+	var tables []*Table
+	if err := json.Unmarshal(bytes, &tables); err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal snapshot: %w", err)
+	}
+	return tables, nil
+}
+
+func WriteSnapshot(path string, tables []*Table) error {
+	bytes, err := json.MarshalIndent(tables, "", "  ")
+	if err != nil {
+		return xerrors.Errorf("cannot marshal snapshot: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return xerrors.Errorf("cannot write snapshot to %s: %w", path, err)
+	}
+	return nil
+}
+
+type Generator struct {
+	cfg *config.Config
+	now func() time.Time
+}
+
+func NewGenerator(cfg *config.Config) *Generator {
+	return &Generator{
+		cfg: cfg,
+		now: time.Now,
+	}
+}
+
+// newVersion returns version by current time. version must be unique, so it is shifted if the same version already exists.
+func (g *Generator) newVersion(path string) (string, error) {
+	now := g.now()
+	for {
+		version := now.Format(versionFormat)
+		matches, err := filepath.Glob(filepath.Join(path, version+"_*.sql"))
+		if err != nil {
+			return "", xerrors.Errorf("cannot find migration files: %w", err)
+		}
+		if len(matches) == 0 {
+			return version, nil
+		}
+		now = now.Add(time.Second)
+	}
+}
+
+// Generate compares tables with snapshot and writes up and down migration files to migration directory.
+// snapshot is updated by tables after writing them. if there is no difference, returns nil.
+func (g *Generator) Generate(tables []*Table, name string) (*Migration, error) {
+	path := g.cfg.MigrationOutputPath()
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, xerrors.Errorf("cannot mkdir for %s: %w", path, err)
+	}
+	snapshotPath := filepath.Join(path, SnapshotFileName)
+	snapshot, err := ReadSnapshot(snapshotPath)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read snapshot: %w", err)
+	}
+	dialect := g.cfg.SQLDialect()
+	up, err := Diff(dialect, snapshot, tables)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get statements to migrate: %w", err)
+	}
+	if len(up) == 0 {
+		return nil, nil
+	}
+	down, err := Diff(dialect, tables, snapshot)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get statements to rollback: %w", err)
+	}
+	if name == "" {
+		name = DefaultName
+	}
+	version, err := g.newVersion(path)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decide version: %w", err)
+	}
+	migration := &Migration{
+		Version: version,
+		Name:    name,
+		Up:      up,
+		Down:    down,
+	}
+	for fileName, statements := range map[string][]string{
+		migration.UpFileName():   migration.Up,
+		migration.DownFileName(): migration.Down,
+	} {
+		if err := ioutil.WriteFile(filepath.Join(path, fileName), []byte(joinStatements(statements)), 0644); err != nil {
+			return nil, xerrors.Errorf("cannot write migration file %s: %w", fileName, err)
+		}
+	}
+	if err := WriteSnapshot(snapshotPath, tables); err != nil {
+		return nil, xerrors.Errorf("failed to write snapshot: %w", err)
+	}
+	return migration, nil
+}
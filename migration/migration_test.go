@@ -0,0 +1,338 @@
+package migration_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"go.knocknote.io/eevee/class"
+	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/migration"
+	"go.knocknote.io/eevee/plugin/dao"
+	"go.knocknote.io/eevee/schema"
+	"go.knocknote.io/eevee/types"
+)
+
+func tablesByClassPath(t *testing.T, dialect types.Dialect, path string) []*migration.Table {
+	t.Helper()
+	classes, err := class.NewReader().ClassByConfig(&config.Config{ClassPath: path})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	tables := []*migration.Table{}
+	for _, class := range classes {
+		table, err := migration.TableFromClass(dialect, class)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("mysql", func(t *testing.T) {
+		v1 := tablesByClassPath(t, types.DialectMySQL, filepath.Join("testdata", "v1"))
+		v2 := tablesByClassPath(t, types.DialectMySQL, filepath.Join("testdata", "v2"))
+		up, err := migration.Diff(types.DialectMySQL, v1, v2)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		expectedUp := []string{
+			"CREATE TABLE `groups` (\n" +
+				"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+				"  `name` varchar(255) NOT NULL,\n" +
+				"  `status` enum('active','closed') NOT NULL DEFAULT 'active',\n" +
+				"  PRIMARY KEY (`id`)\n" +
+				")",
+			"ALTER TABLE `users` DROP COLUMN `age`",
+			"ALTER TABLE `users` MODIFY COLUMN `name` varchar(255) NULL",
+			"ALTER TABLE `users` ADD COLUMN `group_id` bigint unsigned NOT NULL AFTER `name`",
+			"CREATE INDEX `users_group_id_idx` ON `users` (`group_id`)",
+			"DROP TABLE `items`",
+		}
+		if !reflect.DeepEqual(up, expectedUp) {
+			t.Fatalf("unexpected up statements:\n%s", strings.Join(up, "\n"))
+		}
+		down, err := migration.Diff(types.DialectMySQL, v2, v1)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		expectedDown := []string{
+			"CREATE TABLE `items` (\n" +
+				"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+				"  `price` int NOT NULL DEFAULT 100,\n" +
+				"  PRIMARY KEY (`id`)\n" +
+				")",
+			"DROP INDEX `users_group_id_idx` ON `users`",
+			"ALTER TABLE `users` DROP COLUMN `group_id`",
+			"ALTER TABLE `users` MODIFY COLUMN `name` varchar(255) NOT NULL",
+			"ALTER TABLE `users` ADD COLUMN `age` int NOT NULL AFTER `name`",
+			"DROP TABLE `groups`",
+		}
+		if !reflect.DeepEqual(down, expectedDown) {
+			t.Fatalf("unexpected down statements:\n%s", strings.Join(down, "\n"))
+		}
+	})
+	t.Run("postgres", func(t *testing.T) {
+		v1 := tablesByClassPath(t, types.DialectPostgres, filepath.Join("testdata", "v1"))
+		v2 := tablesByClassPath(t, types.DialectPostgres, filepath.Join("testdata", "v2"))
+		up, err := migration.Diff(types.DialectPostgres, v1, v2)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		expectedUp := []string{
+			`CREATE TABLE "groups" (` + "\n" +
+				`  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,` + "\n" +
+				`  "name" text NOT NULL,` + "\n" +
+				`  "status" text NOT NULL DEFAULT 'active' CHECK ("status" IN ('active', 'closed')),` + "\n" +
+				`  PRIMARY KEY ("id")` + "\n" +
+				`)`,
+			`ALTER TABLE "users" DROP COLUMN "age"`,
+			`ALTER TABLE "users" ALTER COLUMN "name" DROP NOT NULL`,
+			`ALTER TABLE "users" ADD COLUMN "group_id" bigint NOT NULL`,
+			`CREATE INDEX "users_group_id_idx" ON "users" ("group_id")`,
+			`DROP TABLE "items"`,
+		}
+		if !reflect.DeepEqual(up, expectedUp) {
+			t.Fatalf("unexpected up statements:\n%s", strings.Join(up, "\n"))
+		}
+	})
+	t.Run("sqlite cannot modify column", func(t *testing.T) {
+		v1 := tablesByClassPath(t, types.DialectSQLite, filepath.Join("testdata", "v1"))
+		v2 := tablesByClassPath(t, types.DialectSQLite, filepath.Join("testdata", "v2"))
+		if _, err := migration.Diff(types.DialectSQLite, v1, v2); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestTableFromSchema(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	tables := tablesByClassPath(t, types.DialectMySQL, filepath.Join("testdata", "v2"))
+	statements, err := migration.Diff(types.DialectMySQL, nil, tables)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	schemaPath := filepath.Join(tmpDir, "schema.sql")
+	if err := ioutil.WriteFile(schemaPath, []byte(strings.Join(statements, ";\n")), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	schemata, err := schema.NewReader().SchemaFromPath(schemaPath)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(schemata) != 2 {
+		t.Fatalf("unexpected schemata %v", schemata)
+	}
+	users := migration.TableFromSchema(types.DialectMySQL, schemata[1])
+	if users.Name != "users" || len(users.Columns) != 3 {
+		t.Fatalf("unexpected table %v", users)
+	}
+	if id := users.Columns[0]; !id.AutoIncrement || id.Nullable {
+		t.Fatalf("unexpected id column %v", id)
+	}
+	if name := users.Columns[1]; !name.Nullable || name.Type != "varchar(255)" {
+		t.Fatalf("unexpected name column %v", name)
+	}
+	if len(users.Keys) != 1 || users.Keys[0].Name != "users_group_id_idx" {
+		t.Fatalf("unexpected keys %v", users.Keys)
+	}
+	groups := migration.TableFromSchema(types.DialectMySQL, schemata[0])
+	if status := groups.Columns[2]; status.Default != "'active'" || !reflect.DeepEqual(status.Enum, []string{"active", "closed"}) {
+		t.Fatalf("unexpected status column %v", status)
+	}
+}
+
+func TestSchemaWriter(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	schemaPath := filepath.Join(tmpDir, "schema")
+	if err := os.MkdirAll(schemaPath, 0755); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	usersSQL := "CREATE TABLE `users` (\n  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n  PRIMARY KEY (`id`)\n);\n"
+	if err := ioutil.WriteFile(filepath.Join(schemaPath, "users.sql"), []byte(usersSQL), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, dialect := range []types.Dialect{types.DialectMySQL, types.DialectPostgres, types.DialectSQLite} {
+		t.Run(string(dialect), func(t *testing.T) {
+			cfg := &config.Config{
+				ClassPath:  filepath.Join("testdata", "v2"),
+				SchemaPath: schemaPath,
+				Dialect:    dialect,
+			}
+			classes, err := class.NewReader().ClassByConfig(cfg)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			reader := schema.NewReaderWithConfig(cfg)
+			schemata, err := reader.SchemaFromPath(schemaPath)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if err := migration.NewSchemaWriter(cfg).Write(classes, schemata); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if content, err := ioutil.ReadFile(filepath.Join(schemaPath, "users.sql")); err != nil || string(content) != usersSQL {
+				t.Fatalf("schema file without generated marker must not be changed: %s", string(content))
+			}
+			schemata, err = reader.SchemaFromPath(schemaPath)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if len(schemata) != 2 || schemata[0].Name != "group" {
+				t.Fatalf("unexpected schemata %v", schemata)
+			}
+			group := schemata[0].ToClass()
+			if len(group.Members) != 3 || group.Index.PrimaryKey.Columns[0] != "id" || !group.Members[0].AutoIncrement {
+				t.Fatalf("unexpected class %v", group)
+			}
+			if status := group.Members[2]; status.Name != "status" || !reflect.DeepEqual(status.Enum, []string{"active", "closed"}) || status.Default != "active" {
+				t.Fatalf("unexpected status member %v", status)
+			}
+
+			// generated schema file is rewritten, and removed with class
+			if err := migration.NewSchemaWriter(cfg).Write(classes, schemata); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if _, err := os.Stat(filepath.Join(schemaPath, "groups.sql")); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if err := migration.NewSchemaWriter(cfg).Write(classes[1:], schemata); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if _, err := os.Stat(filepath.Join(schemaPath, "groups.sql")); err == nil {
+				t.Fatal("schema file of removed class must be removed")
+			}
+
+			// class stored in other datastore has no table
+			classes[0].DataStore = "kvs"
+			if err := migration.NewSchemaWriter(cfg).Write(classes, schemata); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if _, err := os.Stat(filepath.Join(schemaPath, "groups.sql")); err == nil {
+				t.Fatal("schema file must not be written for class stored in other datastore")
+			}
+		})
+	}
+}
+
+func TestHasTable(t *testing.T) {
+	// datastore registered by third-party plugin decides it by HasTable
+	dao.RegisterDataStore("custom-db", &dao.DBDataStore{})
+	for datastore, expected := range map[string]bool{
+		"":          true,
+		"db":        true,
+		"sqlite":    true,
+		"custom-db": true,
+		"kvs":       false,
+	} {
+		if migration.HasTable(&types.Class{DataStore: datastore}) != expected {
+			t.Fatalf("unexpected HasTable of datastore %q", datastore)
+		}
+	}
+}
+
+func execFile(t *testing.T, db *sql.DB, path string) {
+	t.Helper()
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, statement := range strings.Split(string(bytes), ";\n") {
+		if strings.TrimSpace(statement) == "" {
+			continue
+		}
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("failed to exec %s: %+v", statement, err)
+		}
+	}
+}
+
+func tableNames(t *testing.T, db *sql.DB) []string {
+	t.Helper()
+	rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'table' ORDER BY name`)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer rows.Close()
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestGenerate(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	cfg := &config.Config{
+		Dialect:       types.DialectSQLite,
+		MigrationPath: filepath.Join(tmpDir, "migrations"),
+	}
+	tables := tablesByClassPath(t, types.DialectSQLite, filepath.Join("testdata", "v2"))
+	m, err := migration.NewGenerator(cfg).Generate(tables, "create_tables")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if m == nil {
+		t.Fatal("cannot generate migration")
+	}
+	if !strings.HasSuffix(m.UpFileName(), "_create_tables.up.sql") {
+		t.Fatalf("unexpected file name %s", m.UpFileName())
+	}
+	db, err := sql.Open("sqlite3", filepath.Join(tmpDir, "eevee.db"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer db.Close()
+	execFile(t, db, filepath.Join(cfg.MigrationPath, m.UpFileName()))
+	if names := tableNames(t, db); !reflect.DeepEqual(names, []string{"groups", "users"}) {
+		t.Fatalf("unexpected tables %v", names)
+	}
+	if _, err := db.Exec(`INSERT INTO "groups" ("name") VALUES ('eevee')`); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO "groups" ("name", "status") VALUES ('eevee', 'unknown')`); err == nil {
+		t.Fatal("expected error by CHECK constraint")
+	}
+	execFile(t, db, filepath.Join(cfg.MigrationPath, m.DownFileName()))
+	if names := tableNames(t, db); len(names) != 0 {
+		t.Fatalf("unexpected tables %v", names)
+	}
+
+	snapshot, err := migration.ReadSnapshot(filepath.Join(cfg.MigrationPath, migration.SnapshotFileName))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(snapshot) != 2 || snapshot[0].Name != "groups" || snapshot[1].Name != "users" {
+		t.Fatalf("unexpected snapshot %v", snapshot)
+	}
+	m, err = migration.NewGenerator(cfg).Generate(tables, "")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if m != nil {
+		t.Fatalf("unexpected migration %v", m.Up)
+	}
+}
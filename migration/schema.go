@@ -0,0 +1,104 @@
+package migration
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.knocknote.io/eevee/code"
+	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/plural"
+	"go.knocknote.io/eevee/schema"
+	"go.knocknote.io/eevee/types"
+	"golang.org/x/xerrors"
+)
+
+var schemaFileHeader = []byte(fmt.Sprintf("-- %s\n", code.GeneratedMarker))
+
+// CreateTableStatements returns CREATE TABLE statement and CREATE INDEX statements of table
+func CreateTableStatements(dialect types.Dialect, table *Table) []string {
+	b := &ddlBuilder{dialect: dialect}
+	return b.createTable(table)
+}
+
+// SchemaWriter writes schema files for classes written without schema ( class-first workflow ).
+// written file has generated marker, and it is rewritten by class file every time.
+// schema file without the marker is never changed.
+type SchemaWriter struct {
+	cfg *config.Config
+}
+
+func NewSchemaWriter(cfg *config.Config) *SchemaWriter {
+	return &SchemaWriter{cfg: cfg}
+}
+
+func (w *SchemaWriter) fileName(tableName string) string {
+	return fmt.Sprintf("%s.sql", tableName)
+}
+
+func (w *SchemaWriter) isGeneratedSchemaFile(path string) bool {
+	content, err := w.cfg.OutputWriter().ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return bytes.HasPrefix(content, schemaFileHeader)
+}
+
+// Write writes <table>.sql to schema directory for class that has no table in schemata.
+// schemata are read from schema directory, so tables written by the previous time are also contained.
+// if class of generated schema file is removed, the file is also removed.
+func (w *SchemaWriter) Write(classes []*types.Class, schemata []*schema.Schema) error {
+	path := w.cfg.SchemaPath
+	if path == "" {
+		return nil
+	}
+	if info, err := os.Stat(path); err == nil && !info.IsDir() {
+		// tables cannot be written to each file
+		return nil
+	}
+	tableNameMap := map[string]struct{}{}
+	for _, s := range schemata {
+		tableNameMap[plural.Plural(s.Name)] = struct{}{}
+	}
+	dialect := w.cfg.SQLDialect()
+	generatedFileMap := map[string]struct{}{}
+	for _, class := range classes {
+		if !HasTable(class) {
+			continue
+		}
+		tableName := class.Name.PluralSnakeName()
+		schemaPath := filepath.Join(path, w.fileName(tableName))
+		if _, exists := tableNameMap[tableName]; exists && !w.isGeneratedSchemaFile(schemaPath) {
+			continue
+		}
+		table, err := TableFromClass(dialect, class)
+		if err != nil {
+			return xerrors.Errorf("cannot convert class to table: %w", err)
+		}
+		if err := w.cfg.OutputWriter().MkdirAll(path); err != nil {
+			return xerrors.Errorf("cannot mkdir for %s: %w", path, err)
+		}
+		content := append(append([]byte{}, schemaFileHeader...), joinStatements(CreateTableStatements(dialect, table))...)
+		if err := w.cfg.OutputWriter().WriteFile(schemaPath, content, 0644); err != nil {
+			return xerrors.Errorf("cannot write schema file to %s: %w", schemaPath, err)
+		}
+		generatedFileMap[schemaPath] = struct{}{}
+	}
+	matches, err := filepath.Glob(filepath.Join(path, "*.sql"))
+	if err != nil {
+		return xerrors.Errorf("cannot find schema files: %w", err)
+	}
+	for _, schemaPath := range matches {
+		if _, exists := generatedFileMap[schemaPath]; exists {
+			continue
+		}
+		if !w.isGeneratedSchemaFile(schemaPath) {
+			continue
+		}
+		if err := w.cfg.OutputWriter().Remove(schemaPath); err != nil {
+			return xerrors.Errorf("cannot remove schema file %s: %w", schemaPath, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"go.knocknote.io/eevee/plugin/dao"
+	"go.knocknote.io/eevee/plural"
+	"go.knocknote.io/eevee/schema"
+	"go.knocknote.io/eevee/types"
+	"golang.org/x/xerrors"
+)
+
+// Table definition of table to compare with snapshot.
+// it is also written to snapshot file as it is.
+type Table struct {
+	Name       string    `json:"name"`
+	Columns    []*Column `json:"columns"`
+	PrimaryKey []string  `json:"primary_key,omitempty"`
+	UniqueKeys []*Index  `json:"unique_keys,omitempty"`
+	Keys       []*Index  `json:"keys,omitempty"`
+}
+
+// Column definition of column. Default is SQL literal of DEFAULT clause.
+type Column struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Nullable      bool     `json:"nullable,omitempty"`
+	AutoIncrement bool     `json:"auto_increment,omitempty"`
+	Default       string   `json:"default,omitempty"`
+	Enum          []string `json:"enum,omitempty"`
+}
+
+type Index struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+}
+
+func (t *Table) column(name string) *Column {
+	for _, column := range t.Columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+// equals whether columns have the same definition except name
+func (c *Column) equals(column *Column) bool {
+	return c.Type == column.Type &&
+		c.Nullable == column.Nullable &&
+		c.AutoIncrement == column.AutoIncrement &&
+		c.Default == column.Default &&
+		strings.Join(c.Enum, ",") == strings.Join(column.Enum, ",")
+}
+
+// key returns the string to identify index by columns. index is compared by columns because class file doesn't have index name.
+func (i *Index) key() string {
+	return strings.Join(i.Columns, ",")
+}
+
+func indexName(tableName string, columns []string, suffix string) string {
+	return fmt.Sprintf("%s_%s_%s", tableName, strings.Join(columns, "_"), suffix)
+}
+
+var (
+	mysqlTypes = map[string]string{
+		"bool":            "tinyint(1)",
+		"int8":            "tinyint",
+		"int16":           "smallint",
+		"int32":           "int",
+		"int":             "int",
+		"int64":           "bigint",
+		"uint8":           "tinyint unsigned",
+		"uint16":          "smallint unsigned",
+		"uint32":          "int unsigned",
+		"uint":            "bigint unsigned",
+		"uint64":          "bigint unsigned",
+		"float32":         "float",
+		"float64":         "double",
+		"string":          "varchar(255)",
+		"[]byte":          "blob",
+		"time.Time":       "datetime",
+		"json.RawMessage": "json",
+	}
+	postgreSQLTypes = map[string]string{
+		"bool":            "boolean",
+		"int8":            "smallint",
+		"int16":           "smallint",
+		"int32":           "integer",
+		"int":             "integer",
+		"int64":           "bigint",
+		"uint8":           "smallint",
+		"uint16":          "integer",
+		"uint32":          "bigint",
+		"uint":            "bigint",
+		"uint64":          "bigint",
+		"float32":         "real",
+		"float64":         "double precision",
+		"string":          "text",
+		"[]byte":          "bytea",
+		"time.Time":       "timestamp with time zone",
+		"json.RawMessage": "jsonb",
+	}
+	sqliteTypes = map[string]string{
+		"bool":            "boolean",
+		"int8":            "integer",
+		"int16":           "integer",
+		"int32":           "integer",
+		"int":             "integer",
+		"int64":           "bigint",
+		"uint8":           "integer",
+		"uint16":          "integer",
+		"uint32":          "integer",
+		"uint":            "unsigned big int",
+		"uint64":          "unsigned big int",
+		"float32":         "float",
+		"float64":         "double",
+		"string":          "text",
+		"[]byte":          "blob",
+		"time.Time":       "datetime",
+		"json.RawMessage": "json",
+	}
+)
+
+// goTypeName returns type name used to decide SQL type.
+// custom primitive type ( declared by primitive_types ) is treated as the original type.
+func goTypeName(decl *types.TypeDeclare) string {
+	switch {
+	case decl.Type.IsTime():
+		return "time.Time"
+	case decl.Type.PackageName == "json" && decl.Type.Name == "RawMessage":
+		return "json.RawMessage"
+	case decl.Type.As != "":
+		return decl.Type.As
+	}
+	return decl.Type.Name
+}
+
+// sqlType decides column type by Go type of member.
+// AUTO_INCREMENT column of SQLite is declared as integer to be an alias of rowid.
+func sqlType(dialect types.Dialect, member *types.Member) (string, error) {
+	typeName := goTypeName(member.Type)
+	switch dialect {
+	case types.DialectPostgres:
+		if strings.HasPrefix(typeName, "[]") && typeName != "[]byte" {
+			elemType, exists := postgreSQLTypes[strings.TrimPrefix(typeName, "[]")]
+			if !exists {
+				return "", xerrors.Errorf("cannot decide SQL type of %s", typeName)
+			}
+			return elemType + "[]", nil
+		}
+		if sqlType, exists := postgreSQLTypes[typeName]; exists {
+			return sqlType, nil
+		}
+	case types.DialectSQLite:
+		if member.AutoIncrement {
+			return "integer", nil
+		}
+		if sqlType, exists := sqliteTypes[typeName]; exists {
+			return sqlType, nil
+		}
+	default:
+		if len(member.Enum) > 0 {
+			values := []string{}
+			for _, value := range member.Enum {
+				values = append(values, quoteString(value))
+			}
+			return fmt.Sprintf("enum(%s)", strings.Join(values, ",")), nil
+		}
+		if sqlType, exists := mysqlTypes[typeName]; exists {
+			return sqlType, nil
+		}
+	}
+	return "", xerrors.Errorf("cannot decide SQL type of %s", typeName)
+}
+
+func quoteString(value string) string {
+	return "'" + strings.Replace(value, "'", "''", -1) + "'"
+}
+
+// defaultLiteral converts default value of member to SQL literal
+func defaultLiteral(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return quoteString(v)
+	}
+	return fmt.Sprint(value)
+}
+
+// HasTable whether class is stored in RDBMS.
+// class can be written by hand for other datastore ( e.g. KVS ), and it has no table.
+// it is decided by HasTable of datastore, so datastore must be registered before calling this.
+func HasTable(class *types.Class) bool {
+	if class.DataStore == "" {
+		return true
+	}
+	datastore := dao.DataStoreByName(class.DataStore)
+	return datastore != nil && datastore.HasTable()
+}
+
+// TableFromClass converts class to table definition.
+// extend members and relation members are not columns, so they are ignored.
+func TableFromClass(dialect types.Dialect, class *types.Class) (*Table, error) {
+	tableName := class.Name.PluralSnakeName()
+	table := &Table{
+		Name:    tableName,
+		Columns: []*Column{},
+	}
+	for _, member := range class.Members {
+		if member.Extend || member.Relation != nil {
+			continue
+		}
+		typ, err := sqlType(dialect, member)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid member %s of %s: %w", member.Name.SnakeName(), class.Name.SnakeName(), err)
+		}
+		table.Columns = append(table.Columns, &Column{
+			Name:          member.Name.SnakeName(),
+			Type:          typ,
+			Nullable:      member.Nullable || member.Type.IsPointer,
+			AutoIncrement: member.AutoIncrement,
+			Default:       defaultLiteral(member.Default),
+			Enum:          member.Enum,
+		})
+	}
+	if class.Index == nil {
+		return table, nil
+	}
+	table.PrimaryKey = class.Index.PrimaryKey.Columns
+	for _, key := range class.Index.UniqueKeys {
+		name := key.Name
+		if name == "" {
+			name = indexName(tableName, key.Columns, "key")
+		}
+		table.UniqueKeys = append(table.UniqueKeys, &Index{Name: name, Columns: key.Columns})
+	}
+	for _, key := range class.Index.Keys {
+		name := key.Name
+		if name == "" {
+			name = indexName(tableName, key.Columns, "idx")
+		}
+		table.Keys = append(table.Keys, &Index{Name: name, Columns: key.Columns})
+	}
+	return table, nil
+}
+
+// TableFromSchema converts schema read from schema files ( or database ) to table definition.
+// column type is used as it is declared.
+func TableFromSchema(dialect types.Dialect, s *schema.Schema) *Table {
+	tableName := plural.Plural(s.Name)
+	table := &Table{
+		Name:    tableName,
+		Columns: []*Column{},
+	}
+	for _, column := range s.Columns {
+		typ := strings.ToLower(column.SQLType)
+		if len(column.Enum) > 0 && dialect == types.DialectPostgres {
+			// enum type of PostgreSQL is expressed by CHECK constraint in migration
+			typ = "text"
+		}
+		var defaultValue string
+		if column.Default != nil {
+			defaultValue = column.Default.Literal
+			if column.Default.IsString {
+				defaultValue = quoteString(column.Default.Literal)
+			}
+		}
+		table.Columns = append(table.Columns, &Column{
+			Name:          column.Name,
+			Type:          typ,
+			Nullable:      column.Nullable,
+			AutoIncrement: column.AutoIncrement,
+			Default:       defaultValue,
+			Enum:          column.Enum,
+		})
+	}
+	table.PrimaryKey = s.Index.PrimaryKey.Columns
+	for _, key := range s.Index.UniqueKeys {
+		name := key.Name
+		if name == "" {
+			name = indexName(tableName, key.Columns, "key")
+		}
+		table.UniqueKeys = append(table.UniqueKeys, &Index{Name: name, Columns: key.Columns})
+	}
+	for _, key := range s.Index.Keys {
+		name := key.Name
+		if name == "" {
+			name = indexName(tableName, key.Columns, "idx")
+		}
+		table.Keys = append(table.Keys, &Index{Name: name, Columns: key.Columns})
+	}
+	return table
+}
@@ -2,8 +2,6 @@ package model
 
 import (
 	"fmt"
-	"io/ioutil"
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -29,10 +27,19 @@ func NewGenerator(cfg *config.Config) *Generator {
 		packageName:  cfg.ModelPackageName(),
 		receiverName: "m",
 		importList:   types.DefaultImportList(cfg.ModulePath, cfg.ContextImportPath()),
+		daoPath:      daoPath(cfg.OutputPathWithPackage(cfg.ModelPackageName())),
 		cfg:          cfg,
 	}
 }
 
+// daoPath returns path of dao package placed at the same level as path
+func daoPath(path string) string {
+	splittedPaths := strings.Split(path, string(filepath.Separator))
+	daoPaths := splittedPaths[:len(splittedPaths)-1]
+	daoPaths = append(daoPaths, "dao")
+	return filepath.Join(daoPaths...)
+}
+
 func (g *Generator) helper(class *types.Class) *types.ModelMethodHelper {
 	return &types.ModelMethodHelper{
 		Class:        class,
@@ -64,7 +71,6 @@ func (g *Generator) addRendererMethod(f *code.File, class *types.Class, r render
 
 func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 	f := code.NewFile(g.packageName)
-	f.HeaderComment(code.GeneratedMarker)
 	for _, importDeclare := range g.importList {
 		f.ImportName(importDeclare.Path, importDeclare.Name)
 	}
@@ -155,7 +161,7 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 	if !class.ReadOnly {
 		g.addMethod(f, g.Create(g.helper(class)))
 		g.addMethod(f, g.Update(g.helper(class)))
-		if class.MemberByName("id") != nil {
+		if len(class.PrimaryKeys()) > 0 {
 			g.addMethod(f, g.Delete(g.helper(class)))
 		}
 		g.addMethod(f, g.SetAlreadyCreated(g.helper(class)))
@@ -180,12 +186,12 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 			g.addMethod(f, g.findAll(g.helper(class), member))
 		}
 	}
-	primaryKey := class.PrimaryKey()
+	primaryKey := class.PrimaryKeys()
 	definedKeyPair := map[string]struct{}{}
-	if primaryKey != nil {
-		definedKeyPair[primaryKey.Name.SnakeName()] = struct{}{}
-		g.addMethod(f, g.FirstBy(g.helper(class), types.Members{primaryKey}))
-		g.addMethod(f, g.FilterBy(g.helper(class), types.Members{primaryKey}))
+	if len(primaryKey) > 0 {
+		definedKeyPair[primaryKey.JoinedName()] = struct{}{}
+		g.addMethod(f, g.FirstBy(g.helper(class), primaryKey))
+		g.addMethod(f, g.FilterBy(g.helper(class), primaryKey))
 	}
 	for _, uniqueKey := range class.UniqueKeys() {
 		if _, exists := definedKeyPair[uniqueKey.JoinedName()]; !exists {
@@ -275,55 +281,73 @@ func (g *Generator) generateModelClass(path string, classes []*types.Class) erro
 	source := []byte(fmt.Sprintf("%#v", f))
 	modelGoPath := filepath.Join(path, "model.go")
 	if g.existsFile(modelGoPath) {
-		if err := os.Remove(modelGoPath); err != nil {
+		if err := g.cfg.OutputWriter().Remove(modelGoPath); err != nil {
 			return xerrors.Errorf("failed to remove file %s: %w", modelGoPath, err)
 		}
 	}
-	if err := ioutil.WriteFile(modelGoPath, source, 0444); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(modelGoPath, source, 0444); err != nil {
 		return xerrors.Errorf("cannot write file %s: %w", path, err)
 	}
 	return nil
 }
 
 func (g *Generator) existsFile(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
+	return g.cfg.OutputWriter().Exists(path)
 }
 
 func (g *Generator) writeFile(class *types.Class, basePath string, source []byte) error {
 	path := filepath.Join(basePath, fmt.Sprintf("%s.go", class.Name.SnakeName()))
 	if g.existsFile(path) {
-		if err := os.Remove(path); err != nil {
+		if err := g.cfg.OutputWriter().Remove(path); err != nil {
 			return xerrors.Errorf("failed to remove file %s: %w", path, err)
 		}
 	}
-	if err := ioutil.WriteFile(path, source, 0444); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(path, source, 0644); err != nil {
 		return xerrors.Errorf("cannot write file %s: %w", path, err)
 	}
 	return nil
 }
 
-func (g *Generator) Generate(classes []*types.Class) error {
+// GenerateClass generates model for class
+func (g *Generator) GenerateClass(class *types.Class) error {
 	path := g.cfg.OutputPathWithPackage(g.packageName)
-	if err := os.MkdirAll(path, 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(path); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", path, err)
 	}
-	splittedPaths := strings.Split(path, string(filepath.Separator))
-	daoPaths := splittedPaths[:len(splittedPaths)-1]
-	daoPaths = append(daoPaths, "dao")
-	daoPath := filepath.Join(daoPaths...)
-	g.daoPath = daoPath
+	source, err := g.generate(class, path)
+	if err != nil {
+		return xerrors.Errorf("cannot generate model for %s: %w", class.Name.SnakeName(), err)
+	}
+	source, err = code.MergeCustomizedFile(g.cfg.OutputWriter(), filepath.Join(path, fmt.Sprintf("%s.go", class.Name.SnakeName())), source)
+	if err != nil {
+		return xerrors.Errorf("cannot merge model for %s: %w", class.Name.SnakeName(), err)
+	}
+	if err := g.writeFile(class, path, source); err != nil {
+		return xerrors.Errorf("cannot write file to %s for %s: %w", path, class.Name.SnakeName(), err)
+	}
+	return nil
+}
+
+// GeneratePackage generates model.go depending on all classes
+func (g *Generator) GeneratePackage(classes []*types.Class) error {
+	path := g.cfg.OutputPathWithPackage(g.packageName)
+	if err := g.cfg.OutputWriter().MkdirAll(path); err != nil {
+		return xerrors.Errorf("cannot create directory to %s: %w", path, err)
+	}
+	if err := g.generateModelClass(path, classes); err != nil {
+		return xerrors.Errorf("cannot generate model.go: %w", err)
+	}
+	return nil
+}
+
+func (g *Generator) Generate(classes []*types.Class) error {
 	for _, class := range classes {
-		source, err := g.generate(class, path)
-		if err != nil {
+		if err := g.GenerateClass(class); err != nil {
 			return xerrors.Errorf("cannot generate model for %s: %w", class.Name.SnakeName(), err)
 		}
-		if err := g.writeFile(class, path, source); err != nil {
-			return xerrors.Errorf("cannot write file to %s for %s: %w", path, class.Name.SnakeName(), err)
-		}
 	}
-	if err := g.generateModelClass(path, classes); err != nil {
-		return xerrors.Errorf("cannot generate model.go: %w", err)
+	if err := g.GeneratePackage(classes); err != nil {
+		return xerrors.Errorf("cannot generate package: %w", err)
 	}
 	return nil
 }
@@ -1,12 +1,15 @@
 package model_test
 
 import (
+	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"go.knocknote.io/eevee/class"
 	"go.knocknote.io/eevee/config"
 	"go.knocknote.io/eevee/model"
+	"go.knocknote.io/eevee/output"
 	_ "go.knocknote.io/eevee/plugin"
 )
 
@@ -23,3 +26,52 @@ func TestGenerate(t *testing.T) {
 		t.Fatalf("%+v", err)
 	}
 }
+
+func TestGenerateWithCustomizedFuncs(t *testing.T) {
+	writer := output.NewMemoryWriter()
+	cfg := &config.Config{
+		ClassPath:  filepath.Join("testdata", "class"),
+		OutputPath: filepath.Join("testdata"),
+		Writer:     writer,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	path := filepath.Join("testdata", "model", "group.go")
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	customized := strings.Replace(
+		string(content),
+		"// generated by eevee\nfunc (m *Groups) First() *Group {",
+		"// First returns the first group\nfunc (m *Groups) First() *Group {",
+		1,
+	)
+	customized += "\nfunc (m *Group) Title() string {\n\treturn strings.Title(m.Name)\n}\n"
+	customized = strings.Replace(customized, "\"strconv\"\n", "\"strconv\"\n\t\"strings\"\n", 1)
+	if err := writer.WriteFile(path, []byte(customized), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := model.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	generated, err := writer.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source := string(generated)
+	if !strings.Contains(source, "func (m *Group) Title() string {") || !strings.Contains(source, "\"strings\"") {
+		t.Fatalf("expected customized function is kept:\n%s", source)
+	}
+	if strings.Count(source, "func (m *Groups) First() *Group {") != 1 {
+		t.Fatalf("expected generated function is replaced by customized one:\n%s", source)
+	}
+	if !strings.Contains(source, "// First returns the first group\nfunc (m *Groups) First() *Group {") {
+		t.Fatalf("expected customized function is kept:\n%s", source)
+	}
+	if !strings.Contains(source, "// generated by eevee\nfunc (m *Group) ToJSON(") {
+		t.Fatalf("expected generated function has marker:\n%s", source)
+	}
+}
@@ -196,6 +196,12 @@ func (g *Generator) Delete(h *types.ModelMethodHelper) *types.Method {
 	decl.Return = append(decl.Return, &types.ValueDeclare{
 		Type: types.TypeDeclareWithType(types.ErrorType),
 	})
+	names := []string{}
+	args := []Code{Id("ctx")}
+	for _, member := range h.Class.PrimaryKeys() {
+		names = append(names, member.Name.CamelName())
+		args = append(args, h.Receiver().Dot(member.Name.CamelName()))
+	}
 	return &types.Method{
 		Decl: decl,
 		Body: []Code{
@@ -203,7 +209,7 @@ func (g *Generator) Delete(h *types.ModelMethodHelper) *types.Method {
 				Comment("for testing"),
 				Return(Nil()),
 			),
-			If(Err().Op(":=").Add(h.DAO().Dot("DeleteByID").Call(Id("ctx"), h.Receiver().Dot("ID"))), Err().Op("!=").Nil()).Block(
+			If(Err().Op(":=").Add(h.DAO().Dot(fmt.Sprintf("DeleteBy%s", strings.Join(names, "And"))).Call(args...)), Err().Op("!=").Nil()).Block(
 				Return(Qual(h.Package("xerrors"), "Errorf").Call(Lit("failed to Delete: %w"), Err())),
 			),
 			Return(Nil()),
@@ -309,24 +315,36 @@ func (g *Generator) CreateForCollection(h *types.ModelMethodHelper) *types.Metho
 	decl.Return = append(decl.Return, &types.ValueDeclare{
 		Type: types.TypeDeclareWithType(types.ErrorType),
 	})
+	className := h.Class.Name.CamelName()
+	daoName := fmt.Sprintf("%sDAO", h.Class.Name.CamelLowerName())
 	return &types.Method{
 		Decl: decl,
 		Body: []Code{
+			If(Len(h.Receiver().Dot("values")).Op("==").Lit(0)).Block(
+				Return(Nil()),
+			),
+			Comment("all values are created by the same repository, so they have the same DAO"),
+			Id(daoName).Op(":=").Add(h.Receiver().Dot("values").Index(Lit(0)).Dot(daoName)),
+			If(Id(daoName).Op("==").Nil()).Block(
+				Comment("for testing"),
+				Return(Nil()),
+			),
+			Id("values").Op(":=").Make(Qual(h.Package("entity"), h.Class.Name.PluralCamelName()), Lit(0), Len(h.Receiver().Dot("values"))),
+			For(List(Id("_"), Id("v")).Op(":=").Range().Add(h.Receiver().Dot("values"))).Block(
+				If(Id("v").Dot("isAlreadyCreated")).Block(
+					Return(Qual(h.Package("xerrors"), "New").Call(Lit("this instance has already created"))),
+				),
+				Id("values").Op("=").Append(Id("values"), Id("v").Dot(className)),
+			),
 			If(
-				Err().Op(":=").Add(h.Receiver().Dot("EachWithError").Call(
-					Func().Params(Id("v").Op("*").Id(h.Class.Name.CamelName())).Id("error").Block(
-						If(Err().Op(":=").Id("v").Dot("Create").Call(Id("ctx")), Err().Op("!=").Nil()).Block(
-							Return(Qual(h.Package("xerrors"), "Errorf").Call(Lit("failed to Create: %w"), Err())),
-						),
-						Return(Nil()),
-					),
-				)),
+				Err().Op(":=").Id(daoName).Dot("CreateMulti").Call(Id("ctx"), Id("values")),
 				Err().Op("!=").Nil(),
 			).Block(
-				Return(Qual(h.Package("xerrors"), "Errorf").Call(
-					Lit(fmt.Sprintf("interrupt iteration for %s: %%w", h.Class.Name.PluralCamelName())),
-					Err(),
-				)),
+				Return(Qual(h.Package("xerrors"), "Errorf").Call(Lit("failed to CreateMulti: %w"), Err())),
+			),
+			For(List(Id("_"), Id("v")).Op(":=").Range().Add(h.Receiver().Dot("values"))).Block(
+				Id("v").Dot("savedValue").Op("=").Op("*").Id("v").Dot(className),
+				Id("v").Dot("isAlreadyCreated").Op("=").True(),
 			),
 			Return(Nil()),
 		},
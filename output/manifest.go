@@ -0,0 +1,122 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+)
+
+// ManifestFilePath path of manifest that keeps files generated by the last `eevee run`
+const ManifestFilePath = ".eevee.manifest"
+
+// Hash returns hash of content written to manifest
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Manifest paths and hashes of generated files.
+// it is written in the same format as sha256sum ( <hash>  <path> ) to be readable in code review.
+type Manifest struct {
+	files map[string]string
+}
+
+func NewManifest() *Manifest {
+	return &Manifest{files: map[string]string{}}
+}
+
+// ReadManifest reads manifest from path through writer. if manifest doesn't exist, returns empty manifest.
+func ReadManifest(w Writer, path string) (*Manifest, error) {
+	manifest := NewManifest()
+	if !w.Exists(path) {
+		return manifest, nil
+	}
+	content, err := w.ReadFile(path)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read manifest %s: %w", path, err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		splitted := strings.SplitN(line, "  ", 2)
+		if len(splitted) != 2 {
+			return nil, xerrors.Errorf("invalid line in manifest %s: %s", path, line)
+		}
+		manifest.files[splitted[1]] = splitted[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("cannot scan manifest %s: %w", path, err)
+	}
+	return manifest, nil
+}
+
+func (m *Manifest) Add(path, hash string) {
+	m.files[path] = hash
+}
+
+func (m *Manifest) Contains(path string) bool {
+	_, exists := m.files[path]
+	return exists
+}
+
+func (m *Manifest) Hash(path string) string {
+	return m.files[path]
+}
+
+// Paths returns paths in manifest in sorted order
+func (m *Manifest) Paths() []string {
+	paths := make([]string, 0, len(m.files))
+	for path := range m.files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (m *Manifest) Bytes() []byte {
+	var buf bytes.Buffer
+	for _, path := range m.Paths() {
+		fmt.Fprintf(&buf, "%s  %s\n", m.files[path], path)
+	}
+	return buf.Bytes()
+}
+
+// Recorder records files written through Writer to create manifest
+type Recorder struct {
+	Writer
+	mu       sync.Mutex
+	manifest *Manifest
+}
+
+func NewRecorder(w Writer) *Recorder {
+	return &Recorder{
+		Writer:   w,
+		manifest: NewManifest(),
+	}
+}
+
+func (r *Recorder) WriteFile(path string, content []byte, perm os.FileMode) error {
+	if err := r.Writer.WriteFile(path, content, perm); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.manifest.Add(path, Hash(content))
+	return nil
+}
+
+// Manifest returns manifest of recorded files
+func (r *Recorder) Manifest() *Manifest {
+	return r.manifest
+}
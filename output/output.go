@@ -0,0 +1,183 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/xerrors"
+)
+
+// Writer writes generated files.
+// generators read back files through Writer too, because they may read files written by themselves ( e.g. testdata ).
+type Writer interface {
+	MkdirAll(path string) error
+	WriteFile(path string, content []byte, perm os.FileMode) error
+	Remove(path string) error
+	ReadFile(path string) ([]byte, error)
+	Exists(path string) bool
+}
+
+// FileWriter writes files to disk
+type FileWriter struct{}
+
+func NewFileWriter() *FileWriter {
+	return &FileWriter{}
+}
+
+func (w *FileWriter) MkdirAll(path string) error {
+	return os.MkdirAll(path, 0755)
+}
+
+func (w *FileWriter) WriteFile(path string, content []byte, perm os.FileMode) error {
+	return ioutil.WriteFile(path, content, perm)
+}
+
+func (w *FileWriter) Remove(path string) error {
+	return os.Remove(path)
+}
+
+func (w *FileWriter) ReadFile(path string) ([]byte, error) {
+	return ioutil.ReadFile(path)
+}
+
+func (w *FileWriter) Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// MemoryWriter keeps written files in memory instead of writing them to disk.
+// files on disk are never changed, and they are used to compare with written files.
+type MemoryWriter struct {
+	mu      sync.Mutex
+	files   map[string][]byte
+	removed map[string]struct{}
+}
+
+func NewMemoryWriter() *MemoryWriter {
+	return &MemoryWriter{
+		files:   map[string][]byte{},
+		removed: map[string]struct{}{},
+	}
+}
+
+func (w *MemoryWriter) MkdirAll(path string) error {
+	return nil
+}
+
+func (w *MemoryWriter) WriteFile(path string, content []byte, perm os.FileMode) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.files[path] = append([]byte{}, content...)
+	delete(w.removed, path)
+	return nil
+}
+
+func (w *MemoryWriter) Remove(path string) error {
+	if !w.Exists(path) {
+		return xerrors.Errorf("cannot remove %s: %w", path, os.ErrNotExist)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.files, path)
+	w.removed[path] = struct{}{}
+	return nil
+}
+
+func (w *MemoryWriter) ReadFile(path string) ([]byte, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if content, exists := w.files[path]; exists {
+		return content, nil
+	}
+	if _, exists := w.removed[path]; exists {
+		return nil, xerrors.Errorf("cannot read %s: %w", path, os.ErrNotExist)
+	}
+	return ioutil.ReadFile(path)
+}
+
+func (w *MemoryWriter) Exists(path string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, exists := w.files[path]; exists {
+		return true
+	}
+	if _, exists := w.removed[path]; exists {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// FileDiff difference between written file and file on disk.
+// Diff is unified diff from file on disk to written file.
+type FileDiff struct {
+	Path string
+	Diff string
+}
+
+func unifiedDiff(path string, before, after []byte, fromFile, toFile string) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(before)),
+		B:        difflib.SplitLines(string(after)),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return "", xerrors.Errorf("cannot get diff of %s: %w", path, err)
+	}
+	if !strings.HasSuffix(diff, "\n") {
+		diff += "\n"
+	}
+	return diff, nil
+}
+
+// Diff returns differences between written files and files on disk in order of path.
+// removed file that exists on disk is also returned.
+func (w *MemoryWriter) Diff() ([]*FileDiff, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	paths := []string{}
+	for path := range w.files {
+		paths = append(paths, path)
+	}
+	for path := range w.removed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	diffs := []*FileDiff{}
+	for _, path := range paths {
+		var current []byte
+		fromFile := fmt.Sprintf("a/%s", path)
+		if _, err := os.Stat(path); err == nil {
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot read file %s: %w", path, err)
+			}
+			current = content
+		} else if _, exists := w.removed[path]; exists {
+			continue
+		} else {
+			fromFile = "/dev/null"
+		}
+		toFile := fmt.Sprintf("b/%s", path)
+		content, exists := w.files[path]
+		if !exists {
+			toFile = "/dev/null"
+		} else if bytes.Equal(current, content) && fromFile != "/dev/null" {
+			continue
+		}
+		diff, err := unifiedDiff(path, current, content, fromFile, toFile)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get diff: %w", err)
+		}
+		diffs = append(diffs, &FileDiff{Path: path, Diff: diff})
+	}
+	return diffs, nil
+}
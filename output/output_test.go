@@ -0,0 +1,142 @@
+package output_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.knocknote.io/eevee/output"
+)
+
+func TestMemoryWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eevee-output")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(dir)
+	samePath := filepath.Join(dir, "same.go")
+	changedPath := filepath.Join(dir, "changed.go")
+	removedPath := filepath.Join(dir, "removed.go")
+	newPath := filepath.Join(dir, "new.go")
+	for path, content := range map[string]string{
+		samePath:    "package a\n",
+		changedPath: "package a\n\nvar a = 1\n",
+		removedPath: "package a\n",
+	} {
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	writer := output.NewMemoryWriter()
+	for path, content := range map[string]string{
+		samePath:    "package a\n",
+		changedPath: "package a\n\nvar a = 2\n",
+		newPath:     "package a\n",
+	} {
+		if err := writer.WriteFile(path, []byte(content), 0444); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	if err := writer.Remove(removedPath); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := writer.Remove(filepath.Join(dir, "unknown.go")); err == nil {
+		t.Fatal("expected error for removing file that doesn't exist")
+	}
+	t.Run("read written file", func(t *testing.T) {
+		content, err := writer.ReadFile(changedPath)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if string(content) != "package a\n\nvar a = 2\n" {
+			t.Fatalf("unexpected content %q", content)
+		}
+		if !writer.Exists(newPath) {
+			t.Fatal("expected written file exists")
+		}
+		if writer.Exists(removedPath) {
+			t.Fatal("expected removed file doesn't exist")
+		}
+	})
+	t.Run("disk is not changed", func(t *testing.T) {
+		content, err := ioutil.ReadFile(changedPath)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if string(content) != "package a\n\nvar a = 1\n" {
+			t.Fatalf("unexpected content %q", content)
+		}
+		if _, err := os.Stat(newPath); err == nil {
+			t.Fatal("expected new file isn't written to disk")
+		}
+		if _, err := os.Stat(removedPath); err != nil {
+			t.Fatal("expected removed file exists on disk")
+		}
+	})
+	t.Run("diff", func(t *testing.T) {
+		diffs, err := writer.Diff()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if len(diffs) != 3 {
+			t.Fatalf("expected 3 diffs but got %d", len(diffs))
+		}
+		if diffs[0].Path != changedPath {
+			t.Fatalf("unexpected path %s", diffs[0].Path)
+		}
+		if !strings.Contains(diffs[0].Diff, "-var a = 1\n+var a = 2\n") {
+			t.Fatalf("unexpected diff %s", diffs[0].Diff)
+		}
+		if diffs[1].Path != newPath || !strings.HasPrefix(diffs[1].Diff, "--- /dev/null\n") {
+			t.Fatalf("unexpected diff %s", diffs[1].Diff)
+		}
+		if diffs[2].Path != removedPath || !strings.Contains(diffs[2].Diff, "+++ /dev/null\n") {
+			t.Fatalf("unexpected diff %s", diffs[2].Diff)
+		}
+	})
+}
+
+func TestManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eevee-output")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(dir)
+	recorder := output.NewRecorder(output.NewFileWriter())
+	userPath := filepath.Join(dir, "user.go")
+	groupPath := filepath.Join(dir, "group.go")
+	if err := recorder.WriteFile(userPath, []byte("package user\n"), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := recorder.WriteFile(groupPath, []byte("package group\n"), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	manifest := recorder.Manifest()
+	if paths := manifest.Paths(); !reflect.DeepEqual(paths, []string{groupPath, userPath}) {
+		t.Fatalf("unexpected paths %v", paths)
+	}
+	if manifest.Hash(userPath) != output.Hash([]byte("package user\n")) {
+		t.Fatalf("unexpected hash %s", manifest.Hash(userPath))
+	}
+	manifestPath := filepath.Join(dir, output.ManifestFilePath)
+	if err := ioutil.WriteFile(manifestPath, manifest.Bytes(), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	readManifest, err := output.ReadManifest(output.NewFileWriter(), manifestPath)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !reflect.DeepEqual(readManifest, manifest) {
+		t.Fatalf("unexpected manifest %s", readManifest.Bytes())
+	}
+	emptyManifest, err := output.ReadManifest(output.NewFileWriter(), filepath.Join(dir, "unknown"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(emptyManifest.Paths()) != 0 {
+		t.Fatalf("expected empty manifest")
+	}
+}
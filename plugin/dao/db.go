@@ -2,6 +2,7 @@ package dao
 
 import (
 	"fmt"
+	"strings"
 
 	. "go.knocknote.io/eevee/code"
 	"go.knocknote.io/eevee/types"
@@ -24,6 +25,10 @@ func (*DBDataStore) Imports(pkgs types.ImportList) types.ImportList {
 			Path: "database/sql",
 			Name: "sql",
 		},
+		{
+			Path: "strings",
+			Name: "strings",
+		},
 		{
 			Path: "golang.org/x/xerrors",
 			Name: "xerrors",
@@ -75,24 +80,44 @@ func (*DBDataStore) Constructor(p *types.ConstructorParam) []Code {
 	}
 }
 
+// Dialect DBDataStore uses dialect in configuration file
+func (*DBDataStore) Dialect() types.Dialect {
+	return ""
+}
+
+func (*DBDataStore) HasTable() bool {
+	return true
+}
+
 func (*DBDataStore) Create(p *types.CreateParam) []Code {
-	values := []*Statement{}
-	var idMember *types.Member
-	for _, member := range p.Class.Members {
-		if member.Relation != nil {
-			continue
-		}
-		if member.Extend {
-			continue
-		}
-		values = append(values, p.Args.Value().Dot(member.Name.CamelName()))
-		if member.Name.SnakeName() == "id" {
-			idMember = member
+	autoIncrementMember := p.Class.AutoIncrementMember()
+	args := []Code{p.Args.Context(), Id("query")}
+	args = append(args, p.SQL.Args...)
+	if len(p.SQL.ScanValues) > 0 {
+		// assign values returned by INSERT ... RETURNING
+		return []Code{
+			Id("query").Op(":=").Lit(p.SQL.Query),
+			If(
+				Err().Op(":=").Add(p.Field("tx").Dot("QueryRowContext").Call(args...)).Dot("Scan").Call(p.SQL.ScanValues...),
+				Err().Op("!=").Nil(),
+			).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("query"), Id("err"))),
+			),
+			Return(Nil()),
 		}
 	}
-	args := []Code{p.Args.Context(), Id("query")}
-	for _, value := range values {
-		args = append(args, value)
+	if p.Dialect.SupportsReturning() || autoIncrementMember == nil {
+		// primary key is specified by application ( e.g. UUID or composite primary key )
+		return []Code{
+			Id("query").Op(":=").Lit(p.SQL.Query),
+			If(
+				List(Id("_"), Err()).Op(":=").Add(p.Field("tx").Dot("ExecContext").Call(args...)),
+				Err().Op("!=").Nil(),
+			).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("query"), Id("err"))),
+			),
+			Return(Nil()),
+		}
 	}
 	return []Code{
 		Id("query").Op(":=").Lit(p.SQL.Query),
@@ -106,30 +131,211 @@ func (*DBDataStore) Create(p *types.CreateParam) []Code {
 		If(Err().Op("!=").Nil()).Block(
 			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("cannot get LastInsertId: %w"), Id("err"))),
 		),
-		Id("value").Dot("ID").Op("=").Add(idMember.Type.Code(p.ImportList)).Call(Id("id")), // TODO: need to AUTO_INCREMENT column only
+		Id("value").Dot(autoIncrementMember.Name.CamelName()).Op("=").Add(autoIncrementMember.Type.Code(p.ImportList)).Call(Id("id")),
 		Return(Nil()),
 	}
 }
 
-func (*DBDataStore) Update(p *types.UpdateParam) []Code {
-	values := []Code{}
-	var idMember *types.Member
-	for _, member := range p.Class.Members {
-		if member.Relation != nil {
-			continue
+// CreateMulti inserts values by multi-row INSERT query ( e.g. INSERT INTO users (id, name) VALUES (?, ?), (?, ?) ).
+// values are split into chunks of ChunkSize rows, and auto increment values are assigned to them after each query.
+// values assigned by LastInsertId are computed on the assumption described in README.
+func (*DBDataStore) CreateMulti(p *types.CreateMultiParam) []Code {
+	return insertMulti(p, p.Class.AutoIncrementMember())
+}
+
+// insertMulti returns codes to exec multi-row INSERT query by each chunk.
+// if autoIncrementMember is nil, auto increment values are not assigned by LastInsertId.
+func insertMulti(p *types.CreateMultiParam, autoIncrementMember *types.Member) []Code {
+	var placeholder Code
+	if p.Dialect.IsNumberedPlaceholder() {
+		// ($1, $2), ($3, $4), ...
+		formats := []string{}
+		indexes := []Code{}
+		for i := range p.SQL.Args {
+			formats = append(formats, "$%d")
+			indexes = append(indexes, Len(Id("args")).Op("+").Lit(i+1))
 		}
-		if member.Extend {
-			continue
+		placeholder = Qual(p.Package("fmt"), "Sprintf").Call(
+			append([]Code{Lit(fmt.Sprintf("(%s)", strings.Join(formats, ", ")))}, indexes...)...,
+		)
+	} else {
+		placeholders := []string{}
+		for i := range p.SQL.Args {
+			placeholders = append(placeholders, p.Dialect.Placeholder(i+1))
 		}
-		if member.Name.SnakeName() == "id" {
-			idMember = member
+		placeholder = Lit(fmt.Sprintf("(%s)", strings.Join(placeholders, ", ")))
+	}
+	body := []Code{
+		Id("chunk").Op(":=").Add(p.Args.Values()),
+		If(Len(Id("chunk")).Op(">").Lit(p.ChunkSize)).Block(
+			Id("chunk").Op("=").Add(p.Args.Values()).Index(Op(":").Lit(p.ChunkSize)),
+		),
+		p.Args.Values().Op("=").Add(p.Args.Values()).Index(Len(Id("chunk")).Op(":")),
+		Id("placeholders").Op(":=").Make(Index().String(), Lit(0), Len(Id("chunk"))),
+		Id("args").Op(":=").Make(Index().Interface(), Lit(0), Len(Id("chunk")).Op("*").Lit(len(p.SQL.Args))),
+		For(List(Id("_"), p.Args.Value()).Op(":=").Range().Id("chunk")).Block(
+			Id("placeholders").Op("=").Append(Id("placeholders"), placeholder),
+			Id("args").Op("=").Append(append([]Code{Id("args")}, p.SQL.Args...)...),
+		),
+		Id("query").Op(":=").Qual(p.Package("fmt"), "Sprintf").Call(
+			Lit(p.SQL.Query),
+			Qual(p.Package("strings"), "Join").Call(Id("placeholders"), Lit(", ")),
+		),
+	}
+	queryError := Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("query"), Id("err")))
+	switch {
+	case len(p.SQL.ScanValues) > 0:
+		// assign values returned by INSERT ... RETURNING in order of rows
+		body = append(body,
+			List(Id("rows"), Err()).Op(":=").Add(p.Field("tx").Dot("QueryContext").Call(p.Args.Context(), Id("query"), Id("args").Op("..."))),
+			If(Err().Op("!=").Nil()).Block(queryError),
+			For(List(Id("_"), p.Args.Value()).Op(":=").Range().Id("chunk")).Block(
+				If(Op("!").Id("rows").Dot("Next").Call()).Block(Break()),
+				If(
+					Err().Op(":=").Id("rows").Dot("Scan").Call(p.SQL.ScanValues...),
+					Err().Op("!=").Nil(),
+				).Block(
+					Id("rows").Dot("Close").Call(),
+					Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to scan: %w"), Err())),
+				),
+			),
+			If(Err().Op(":=").Id("rows").Dot("Close").Call(), Err().Op("!=").Nil()).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("cannot close rows: %w"), Err())),
+			),
+			If(Err().Op(":=").Id("rows").Dot("Err").Call(), Err().Op("!=").Nil()).Block(queryError),
+		)
+	case p.Dialect.SupportsReturning() || autoIncrementMember == nil:
+		// primary key is specified by application ( e.g. UUID or composite primary key ) or cannot be got
+		body = append(body,
+			If(
+				List(Id("_"), Err()).Op(":=").Add(p.Field("tx").Dot("ExecContext").Call(p.Args.Context(), Id("query"), Id("args").Op("..."))),
+				Err().Op("!=").Nil(),
+			).Block(queryError),
+		)
+	default:
+		field := p.Args.Value().Dot(autoIncrementMember.Name.CamelName())
+		if !p.Dialect.OmitsGeneratedID() {
+			// auto increment member is inserted, so values specified by application are kept.
+			// database assigns values only to rows whose value is zero, and they cannot be got if rows are mixed.
+			body = append(body,
+				Id("assigned").Op(":=").Lit(0),
+				For(List(Id("_"), p.Args.Value()).Op(":=").Range().Id("chunk")).Block(
+					If(field.Clone().Op("==").Lit(0)).Block(Id("assigned").Op("++")),
+				),
+				If(Id("assigned").Op(">").Lit(0).Op("&&").Id("assigned").Op("<").Len(Id("chunk"))).Block(
+					Return(Qual(p.Package("xerrors"), "New").Call(Lit(fmt.Sprintf(
+						"cannot create records with and without %s at once", autoIncrementMember.Name.SnakeName(),
+					)))),
+				),
+			)
+		}
+		body = append(body,
+			List(Id("result"), Err()).Op(":=").Add(p.Field("tx").Dot("ExecContext").Call(p.Args.Context(), Id("query"), Id("args").Op("..."))),
+			If(Err().Op("!=").Nil()).Block(queryError),
+		)
+		assign := []Code{
+			List(Id("id"), Err()).Op(":=").Id("result").Dot("LastInsertId").Call(),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("cannot get LastInsertId: %w"), Id("err"))),
+			),
+		}
+		if p.Dialect.LastInsertIDIsLastRow() {
+			assign = append(assign, Id("id").Op("-=").Int64().Call(Len(Id("chunk")).Op("-").Lit(1)))
+		}
+		assign = append(assign,
+			For(List(Id("i"), p.Args.Value()).Op(":=").Range().Id("chunk")).Block(
+				field.Clone().Op("=").Add(autoIncrementMember.Type.Code(p.ImportList)).Call(Id("id").Op("+").Int64().Call(Id("i"))),
+			),
+		)
+		if p.Dialect.OmitsGeneratedID() {
+			body = append(body, assign...)
 		} else {
-			values = append(values, Id("value").Dot(member.Name.CamelName()))
+			body = append(body, If(Id("assigned").Op(">").Lit(0)).Block(assign...))
 		}
 	}
-	values = append(values, Id("value").Dot(idMember.Name.CamelName()))
 	return []Code{
-		Id("args").Op(":=").Index().Interface().Values(values...),
+		For(Len(p.Args.Values()).Op(">").Lit(0)).Block(body...),
+		Return(Nil()),
+	}
+}
+
+func (*DBDataStore) Upsert(p *types.UpsertParam) []Code {
+	autoIncrementMember := p.Class.AutoIncrementMember()
+	args := []Code{p.Args.Context(), Id("query")}
+	args = append(args, p.SQL.Args...)
+	queryError := Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("query"), Id("err")))
+	if len(p.SQL.ScanValues) > 0 {
+		// assign values returned by INSERT ... RETURNING
+		return []Code{
+			Id("query").Op(":=").Lit(p.SQL.Query),
+			If(
+				Err().Op(":=").Add(p.Field("tx").Dot("QueryRowContext").Call(args...)).Dot("Scan").Call(p.SQL.ScanValues...),
+				Err().Op("!=").Nil(),
+			).Block(queryError),
+			Return(Nil()),
+		}
+	}
+	if autoIncrementMember != nil && p.FindSQL == nil {
+		// LastInsertId returns ID of inserted or updated record
+		return []Code{
+			Id("query").Op(":=").Lit(p.SQL.Query),
+			List(Id("result"), Err()).Op(":=").Add(p.Field("tx").Dot("ExecContext").Call(args...)),
+			If(Err().Op("!=").Nil()).Block(queryError),
+			List(Id("id"), Err()).Op(":=").Id("result").Dot("LastInsertId").Call(),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("cannot get LastInsertId: %w"), Id("err"))),
+			),
+			Id("value").Dot(autoIncrementMember.Name.CamelName()).Op("=").Add(autoIncrementMember.Type.Code(p.ImportList)).Call(Id("id")),
+			Return(Nil()),
+		}
+	}
+	body := []Code{
+		Id("query").Op(":=").Lit(p.SQL.Query),
+		If(
+			List(Id("_"), Err()).Op(":=").Add(p.Field("tx").Dot("ExecContext").Call(args...)),
+			Err().Op("!=").Nil(),
+		).Block(queryError),
+	}
+	if p.FindSQL != nil {
+		// find auto increment value of upserted record by unique key
+		findArgs := []Code{p.Args.Context(), Id("findQuery")}
+		findArgs = append(findArgs, p.FindSQL.Args...)
+		body = append(body,
+			Id("findQuery").Op(":=").Lit(p.FindSQL.Query),
+			If(
+				Err().Op(":=").Add(p.Field("tx").Dot("QueryRowContext").Call(findArgs...)).Dot("Scan").Call(p.FindSQL.ScanValues...),
+				Err().Op("!=").Nil(),
+			).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("findQuery"), Id("err"))),
+			),
+		)
+	}
+	return append(body, Return(Nil()))
+}
+
+func (*DBDataStore) UpsertMulti(p *types.UpsertMultiParam) []Code {
+	// LastInsertId cannot be used for multi-row upsert query because updated records are mixed,
+	// so auto increment values are assigned only by INSERT ... RETURNING
+	return insertMulti(&types.CreateMultiParam{
+		DataAccessParam: p.DataAccessParam,
+		Args:            p.Args,
+		ChunkSize:       p.ChunkSize,
+	}, nil)
+}
+
+func (*DBDataStore) SupportsOptimisticLock() bool {
+	return true
+}
+
+func (s *DBDataStore) Update(p *types.UpdateParam) []Code {
+	if p.SQL.Query == "" {
+		return []Code{Return(Nil())}
+	}
+	if p.LockVersionMember != nil {
+		return s.updateWithLockVersion(p)
+	}
+	return []Code{
+		Id("args").Op(":=").Index().Interface().Values(p.SQL.Args...),
 		Id("query").Op(":=").Lit(p.SQL.Query),
 		If(
 			List(Id("_"), Err()).Op(":=").Add(
@@ -143,12 +349,40 @@ func (*DBDataStore) Update(p *types.UpdateParam) []Code {
 	}
 }
 
+// updateWithLockVersion returns codes to update record only if its version is not changed after it was found.
+// no affected rows means that other transaction updated or deleted the record, so it returns ErrStaleObject.
+func (*DBDataStore) updateWithLockVersion(p *types.UpdateParam) []Code {
+	return []Code{
+		Id("args").Op(":=").Index().Interface().Values(p.SQL.Args...),
+		Id("query").Op(":=").Lit(p.SQL.Query),
+		List(Id("result"), Err()).Op(":=").Add(
+			p.Field("tx").Dot("ExecContext").Call(p.Args.Context(), Id("query"), Id("args").Op("...")),
+		),
+		If(Err().Op("!=").Nil()).Block(
+			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("query"), Id("err"))),
+		),
+		List(Id("affected"), Err()).Op(":=").Id("result").Dot("RowsAffected").Call(),
+		If(Err().Op("!=").Nil()).Block(
+			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("cannot get affected rows: %w"), Err())),
+		),
+		If(Id("affected").Op("==").Lit(0)).Block(
+			Return(Qual(p.Package("xerrors"), "Errorf").Call(
+				Lit(fmt.Sprintf("cannot update %s by %s %%v: %%w", p.Class.Name.PluralSnakeName(), p.LockVersionMember.Name.SnakeName())),
+				p.Args.Value().Dot(p.LockVersionMember.Name.CamelName()),
+				Id(StaleObjectErrorName),
+			)),
+		),
+		p.Args.Value().Dot(p.LockVersionMember.Name.CamelName()).Op("++"),
+		Return(Nil()),
+	}
+}
+
 func (*DBDataStore) Delete(p *types.DeleteParam) []Code {
 	return []Code{
 		Id("query").Op(":=").Lit(p.SQL.Query),
 		If(
 			List(Id("_"), Err()).Op(":=").Add(
-				p.Field("tx").Dot("ExecContext").Call(p.Args.Context(), Id("query"), Id("value").Dot("ID")),
+				p.Field("tx").Dot("ExecContext").Call(append([]Code{p.Args.Context(), Id("query")}, p.SQL.Args...)...),
 			),
 			Err().Op("!=").Nil(),
 		).Block(
@@ -158,7 +392,13 @@ func (*DBDataStore) Delete(p *types.DeleteParam) []Code {
 	}
 }
 
-func (*DBDataStore) FindAll(p *types.FindParam) []Code {
+func (s *DBDataStore) FindAll(p *types.FindParam) []Code {
+	if p.Args.Option != nil {
+		return s.FindWithOption(p, []Code{
+			Id("query").Op(":=").Lit(p.SQL.Query),
+			Id("args").Op(":=").Index().Interface().Values(),
+		})
+	}
 	return []Code{
 		Id("values").Op(":=").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()).Block(),
 		Id("query").Op(":=").Lit(p.SQL.Query),
@@ -205,7 +445,7 @@ func (*DBDataStore) Count(p *types.CountParam) []Code {
 	}
 }
 
-func (s *DBDataStore) findForSingleReturnValue(p *types.FindParam, query string, queryArgs []Code, scanValues []Code) []Code {
+func (s *DBDataStore) findForSingleReturnValue(p types.DataAccessParam, query string, queryArgs []Code, scanValues []Code) []Code {
 	return []Code{
 		Var().Id("value").Qual(p.Package("entity"), p.Class.Name.CamelName()),
 		Id("query").Op(":=").Lit(query),
@@ -222,7 +462,7 @@ func (s *DBDataStore) findForSingleReturnValue(p *types.FindParam, query string,
 	}
 }
 
-func (s *DBDataStore) findForSliceReturnValue(p *types.FindParam, query string, queryArgs []Code, scanValues []Code) []Code {
+func (s *DBDataStore) findForSliceReturnValue(p types.DataAccessParam, query string, queryArgs []Code, scanValues []Code) []Code {
 	return []Code{
 		Id("values").Op(":=").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()).Block(),
 		Id("query").Op(":=").Lit(query),
@@ -254,18 +494,54 @@ func (s *DBDataStore) findForSliceReturnValue(p *types.FindParam, query string,
 
 func (s *DBDataStore) FindWithQueryArgs(p *types.FindParam, queryArgs []Code) []Code {
 	if p.IsSingleReturnValue {
-		return s.findForSingleReturnValue(p, p.SQL.Query, queryArgs, p.SQL.ScanValues)
+		return s.findForSingleReturnValue(p.DataAccessParam, p.SQL.Query, queryArgs, p.SQL.ScanValues)
+	}
+	return s.findForSliceReturnValue(p.DataAccessParam, p.SQL.Query, queryArgs, p.SQL.ScanValues)
+}
+
+func (s *DBDataStore) Query(p *types.QueryParam) []Code {
+	queryArgs := []Code{Code(p.Args.Context()), Id("query")}
+	queryArgs = append(queryArgs, p.SQL.Args...)
+	if p.IsSingleReturnValue {
+		return s.findForSingleReturnValue(p.DataAccessParam, p.SQL.Query, queryArgs, p.SQL.ScanValues)
 	}
-	return s.findForSliceReturnValue(p, p.SQL.Query, queryArgs, p.SQL.ScanValues)
+	return s.findForSliceReturnValue(p.DataAccessParam, p.SQL.Query, queryArgs, p.SQL.ScanValues)
+}
+
+func (*DBDataStore) SupportsQuery() bool {
+	return true
+}
+
+func (*DBDataStore) SupportsLockingRead() bool {
+	return true
 }
 
 func (s *DBDataStore) FindBy(p *types.FindParam) []Code {
+	if p.Args.Option != nil {
+		return s.FindWithOption(p, []Code{
+			Id("query").Op(":=").Lit(p.SQL.Query),
+			Id("args").Op(":=").Index().Interface().Values(p.SQL.Args...),
+		})
+	}
 	queryArgs := []Code{Code(p.Args.Context()), Id("query")}
 	queryArgs = append(queryArgs, p.SQL.Args...)
 	return s.FindWithQueryArgs(p, queryArgs)
 }
 
-func (*DBDataStore) FindByPlural(p *types.FindParam) []Code {
+func (s *DBDataStore) FindByPlural(p *types.FindParam) []Code {
+	if p.Args.Option != nil {
+		return s.FindWithOption(p, []Code{
+			Id("args").Op(":=").Index().Interface().Values(),
+			Id("placeholders").Op(":=").Make(Index().String(), Lit(0), Len(Id("a0"))),
+			For(
+				List(Id("_"), Id("v")).Op(":=").Range().Id("a0"),
+			).Block(
+				Id("args").Op("=").Append(Id("args"), Id("v")),
+				Id("placeholders").Op("=").Append(Id("placeholders"), placeholder(p.DataAccessParam)),
+			),
+			Id("query").Op(":=").Qual(p.Package("fmt"), "Sprintf").Call(Lit(p.SQL.Query), Qual(p.Package("strings"), "Join").Call(Id("placeholders"), Lit(", "))),
+		})
+	}
 	return []Code{
 		Id("values").Op(":=").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()).Block(),
 		Id("query").Op(":=").Lit(p.SQL.Query),
@@ -275,7 +551,7 @@ func (*DBDataStore) FindByPlural(p *types.FindParam) []Code {
 			List(Id("_"), Id("v")).Op(":=").Range().Id("a0"),
 		).Block(
 			Id("args").Op("=").Append(Id("args"), Id("v")),
-			Id("placeholders").Op("=").Append(Id("placeholders"), Lit("?")),
+			Id("placeholders").Op("=").Append(Id("placeholders"), placeholder(p.DataAccessParam)),
 		),
 		Id("selectQuery").Op(":=").Qual(p.Package("fmt"), "Sprintf").Call(Id("query"), Qual(p.Package("strings"), "Join").Call(Id("placeholders"), Lit(", "))),
 		List(Id("rows"), Err()).Op(":=").Add(p.Field("tx").Dot("QueryContext").Call(p.Args.Context(), Id("selectQuery"), Id("args").Op("..."))),
@@ -304,18 +580,150 @@ func (*DBDataStore) FindByPlural(p *types.FindParam) []Code {
 	}
 }
 
-func (*DBDataStore) UpdateWithAppendStmts(p *types.UpdateParam, appendStmts []Code) []Code {
+// FindWithOption returns codes to find records with ORDER BY, LIMIT and OFFSET clauses specified by option.
+// queryCodes must declare query and args for WHERE clause.
+func (s *DBDataStore) FindWithOption(p *types.FindParam, queryCodes []Code) []Code {
 	codes := []Code{
-		Id("columns").Op(":=").Index().String().Values(),
-		Id("args").Op(":=").Index().Interface().Values(),
+		Id("values").Op(":=").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()).Block(),
+		If(p.Args.Option().Op("==").Nil()).Block(
+			p.Args.Option().Op("=").Op("&").Qual(p.Package("entity"), p.Class.FindOptionStructName()).Values(),
+		),
+	}
+	codes = append(codes, queryCodes...)
+	codes = append(codes, findOptionCodes(p)...)
+	codes = append(codes, []Code{
+		List(Id("rows"), Err()).Op(":=").Add(p.Field("tx").Dot("QueryContext").Call(p.Args.Context(), Id("query"), Id("args").Op("..."))),
+		If(Err().Op("!=").Nil()).Block(
+			Return(List(Id("values"), Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("query"), Id("err")))),
+		),
+		Defer().Func().Call().Block(
+			If(
+				Err().Op(":=").Id("rows").Dot("Close").Call(),
+				Err().Op("!=").Nil(),
+			).Block(
+				Id("e").Op("=").Qual(p.Package("xerrors"), "Errorf").Call(Lit("cannot close rows: %w"), Err()),
+			),
+		).Call(),
+		For(Id("rows").Dot("Next").Call()).Block(
+			Var().Id("value").Qual(p.Package("entity"), p.Class.Name.CamelName()),
+			If(
+				Err().Op(":=").Id("rows").Dot("Scan").Call(p.SQL.ScanValues...),
+				Err().Op("!=").Nil(),
+			).Block(
+				Return(List(Id("values"), Qual(p.Package("xerrors"), "Errorf").Call(Lit("cannot scan value: %w"), Id("err")))),
+			),
+			Id("values").Op("=").Append(Id("values"), Op("&").Id("value")),
+		),
+		Return(List(Id("values"), Nil())),
+	}...)
+	return codes
+}
+
+// orderColumns returns columns of ORDER BY clause to sort records by member.
+// primary key is appended to decide order of records which have the same value.
+func orderColumns(class *types.Class, member *types.Member) types.Members {
+	members := types.Members{}
+	if member != nil {
+		members = append(members, member)
+	}
+	for _, primaryKey := range class.PrimaryKeys() {
+		if primaryKey == member {
+			continue
+		}
+		members = append(members, primaryKey)
+	}
+	return members
+}
+
+// findOptionCodes returns codes to append cursor condition, ORDER BY, LIMIT and OFFSET clauses to query.
+// cursor condition compares columns of ORDER BY clause as row value like (`name`, `id`) > (?, ?).
+func findOptionCodes(p *types.FindParam) []Code {
+	opt := p.Args.Option
+	defaultColumns := orderColumns(p.Class, nil)
+	cases := []Code{}
+	for _, member := range p.Class.OrderMembers() {
+		members := orderColumns(p.Class, member)
+		quotedColumns := []Code{}
+		cursorValues := []Code{}
+		for _, m := range members {
+			quotedColumns = append(quotedColumns, Lit(p.Dialect.Quote(m.Name.SnakeName())))
+			cursorValues = append(cursorValues, opt().Dot("After").Dot(m.Name.CamelName()))
+		}
+		values := []Code{Qual(p.Package("entity"), p.Class.OrderColumnName(member))}
+		if members.JoinedName() == defaultColumns.JoinedName() {
+			values = append([]Code{Lit("")}, values...)
+		}
+		cases = append(cases, Case(values...).Block(
+			Id("orderColumns").Op("=").Index().String().Values(quotedColumns...),
+			If(opt().Dot("After").Op("!=").Nil()).Block(
+				Id("cursorValues").Op("=").Index().Interface().Values(cursorValues...),
+			),
+		))
+	}
+	cases = append(cases, Default().Block(
+		Return(Nil(), Qual(p.Package("xerrors"), "Errorf").Call(
+			Lit(fmt.Sprintf("cannot sort %s by %%s", p.Class.Name.PluralSnakeName())), opt().Dot("OrderBy"),
+		)),
+	))
+	cursorKeyword := "AND"
+	if len(p.Args.Members) == 0 {
+		cursorKeyword = "WHERE"
+	}
+	return []Code{
+		Var().Id("orderColumns").Index().String(),
+		Var().Id("cursorValues").Index().Interface(),
+		Switch(opt().Dot("OrderBy")).Block(cases...),
+		Id("direction").Op(":=").Lit("ASC"),
+		Id("operator").Op(":=").Lit(">"),
+		If(opt().Dot("Desc")).Block(
+			Id("direction").Op("=").Lit("DESC"),
+			Id("operator").Op("=").Lit("<"),
+		),
+		If(Len(Id("cursorValues")).Op(">").Lit(0)).Block(
+			Id("placeholders").Op(":=").Make(Index().String(), Lit(0), Len(Id("cursorValues"))),
+			For(
+				List(Id("_"), Id("v")).Op(":=").Range().Id("cursorValues"),
+			).Block(
+				Id("args").Op("=").Append(Id("args"), Id("v")),
+				Id("placeholders").Op("=").Append(Id("placeholders"), placeholder(p.DataAccessParam)),
+			),
+			Id("query").Op("+=").Qual(p.Package("fmt"), "Sprintf").Call(
+				Lit(fmt.Sprintf(" %s (%%s) %%s (%%s)", cursorKeyword)),
+				Qual(p.Package("strings"), "Join").Call(Id("orderColumns"), Lit(", ")),
+				Id("operator"),
+				Qual(p.Package("strings"), "Join").Call(Id("placeholders"), Lit(", ")),
+			),
+		),
+		Id("orders").Op(":=").Make(Index().String(), Lit(0), Len(Id("orderColumns"))),
 		For(
-			List(Id("column"), Id("v")).Op(":=").Range().Id("updateMap"),
+			List(Id("_"), Id("column")).Op(":=").Range().Id("orderColumns"),
 		).Block(
-			Id("columns").Op("=").Append(Id("columns"), Qual(p.Package("fmt"), "Sprintf").Call(Lit("`%s` = ?"), Id("column"))),
-			Id("args").Op("=").Append(Id("args"), Id("v")),
+			Id("orders").Op("=").Append(Id("orders"), Id("column").Op("+").Lit(" ").Op("+").Id("direction")),
+		),
+		Id("query").Op("+=").Lit(" ORDER BY ").Op("+").Qual(p.Package("strings"), "Join").Call(Id("orders"), Lit(", ")),
+		If(opt().Dot("Limit").Op(">").Lit(0)).Block(
+			Id("args").Op("=").Append(Id("args"), opt().Dot("Limit")),
+			Id("query").Op("+=").Add(clauseWithPlaceholder(p.DataAccessParam, " LIMIT")),
+		).Else().If(opt().Dot("Offset").Op(">").Lit(0)).Block(
+			Id("query").Op("+=").Lit(fmt.Sprintf(" LIMIT %s", p.Dialect.NoLimit())),
+		),
+		If(opt().Dot("Offset").Op(">").Lit(0)).Block(
+			Id("args").Op("=").Append(Id("args"), opt().Dot("Offset")),
+			Id("query").Op("+=").Add(clauseWithPlaceholder(p.DataAccessParam, " OFFSET")),
 		),
 	}
-	codes = append(codes, appendStmts...)
+}
+
+func (*DBDataStore) UpdateWithAppendStmts(p *types.UpdateParam, appendStmts []Code) []Code {
+	codes := declareUpdateColumns(p)
+	if p.Dialect.IsNumberedPlaceholder() {
+		// arguments for WHERE clause are bound to $1, $2, ... so append them before SET clause
+		codes = append(codes, appendStmts...)
+		codes = append(codes, updateColumns(p)...)
+	} else {
+		codes = append(codes, updateColumns(p)...)
+		codes = append(codes, appendStmts...)
+	}
 	codes = append(codes, []Code{
 		Id("query").Op(":=").Qual(p.Package("fmt"), "Sprintf").Call(Lit(p.SQL.Query), Qual(p.Package("strings"), "Join").Call(Id("columns"), Lit(", "))),
 		If(
@@ -338,26 +746,34 @@ func (s *DBDataStore) UpdateBy(p *types.UpdateParam) []Code {
 }
 
 func (*DBDataStore) UpdateByPlural(p *types.UpdateParam) []Code {
-	return []Code{
-		Id("columns").Op(":=").Index().String().Values(),
-		Id("args").Op(":=").Index().Interface().Values(),
-		For(
-			List(Id("column"), Id("v")).Op(":=").Range().Id("updateMap"),
-		).Block(
-			Id("columns").Op("=").Append(Id("columns"), Qual(p.Package("fmt"), "Sprintf").Call(Lit("`%s` = ?"), Id("column"))),
-			Id("args").Op("=").Append(Id("args"), Id("v")),
-		),
-		For(
-			List(Id("_"), Id("v")).Op(":=").Range().Id("a0"),
-		).Block(
-			Id("args").Op("=").Append(Id("args"), Id("v")),
-		),
-		Id("placeholders").Op(":=").Make(Index().String(), Lit(0), Len(Id("a0"))),
-		For(
-			Range().Id("a0"),
-		).Block(
-			Id("placeholders").Op("=").Append(Id("placeholders"), Lit("?")),
-		),
+	codes := declareUpdateColumns(p)
+	codes = append(codes, updateColumns(p)...)
+	if p.Dialect.IsNumberedPlaceholder() {
+		codes = append(codes,
+			Id("placeholders").Op(":=").Make(Index().String(), Lit(0), Len(Id("a0"))),
+			For(
+				List(Id("_"), Id("v")).Op(":=").Range().Id("a0"),
+			).Block(
+				Id("args").Op("=").Append(Id("args"), Id("v")),
+				Id("placeholders").Op("=").Append(Id("placeholders"), placeholder(p.DataAccessParam)),
+			),
+		)
+	} else {
+		codes = append(codes,
+			For(
+				List(Id("_"), Id("v")).Op(":=").Range().Id("a0"),
+			).Block(
+				Id("args").Op("=").Append(Id("args"), Id("v")),
+			),
+			Id("placeholders").Op(":=").Make(Index().String(), Lit(0), Len(Id("a0"))),
+			For(
+				Range().Id("a0"),
+			).Block(
+				Id("placeholders").Op("=").Append(Id("placeholders"), Lit("?")),
+			),
+		)
+	}
+	return append(codes,
 		Id("query").Op(":=").Qual(p.Package("fmt"), "Sprintf").Call(
 			Lit(p.SQL.Query),
 			Qual(p.Package("strings"), "Join").Call(Id("columns"), Lit(", ")),
@@ -370,7 +786,7 @@ func (*DBDataStore) UpdateByPlural(p *types.UpdateParam) []Code {
 			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failure query %s: %w"), Id("query"), Id("err"))),
 		),
 		Return(Nil()),
-	}
+	)
 }
 
 func (*DBDataStore) DeleteWithArgs(p *types.DeleteParam, args []Code) []Code {
@@ -400,7 +816,7 @@ func (*DBDataStore) DeleteByPlural(p *types.DeleteParam) []Code {
 			List(Id("_"), Id("v")).Op(":=").Range().Id("a0"),
 		).Block(
 			Id("args").Op("=").Append(Id("args"), Id("v")),
-			Id("placeholders").Op("=").Append(Id("placeholders"), Lit("?")),
+			Id("placeholders").Op("=").Append(Id("placeholders"), placeholder(p.DataAccessParam)),
 		),
 		Id("query").Op(":=").Qual(p.Package("fmt"), "Sprintf").Call(
 			Lit(p.SQL.Query),
@@ -417,3 +833,135 @@ func (*DBDataStore) DeleteByPlural(p *types.DeleteParam) []Code {
 		Return(Nil()),
 	}
 }
+
+// UpdateColumns returns body of helper method that appends SET clauses and their values to args.
+// updated columns are specified by fields of entity ( e.g. *entity.UserUpdate ), so column names are embedded in generated code.
+// UpdateBy and UpdateByPlural call it instead of repeating same codes per method.
+func (*DBDataStore) UpdateColumns(p *types.UpdateParam) []Code {
+	codes := []Code{
+		Id("columns").Op(":=").Index().String().Values(),
+	}
+	for _, member := range p.Class.UpdateColumnMembers() {
+		field := p.Args.UpdateValue().Dot(member.Name.CamelName())
+		column := p.Dialect.Quote(member.Name.SnakeName())
+		var clause Code
+		if p.Dialect.IsNumberedPlaceholder() {
+			clause = Qual(p.Package("fmt"), "Sprintf").Call(Lit(fmt.Sprintf("%s = $%%d", column)), Len(Id("args")))
+		} else {
+			clause = Lit(fmt.Sprintf("%s = ?", column))
+		}
+		codes = append(codes, If(field.Clone().Op("!=").Nil()).Block(
+			Id("args").Op("=").Append(Id("args"), p.Dialect.ValueCode(member.Type, Op("*").Add(field))),
+			Id("columns").Op("=").Append(Id("columns"), clause),
+		))
+	}
+	return append(codes, Return(Id("columns"), Id("args")))
+}
+
+// declareUpdateColumns returns codes to declare columns and args for UpdateBy methods.
+// columns is declared by updateColumns if `dao.update_map` is disabled.
+// nil updateValue updates nothing like updateValue without fields.
+func declareUpdateColumns(p *types.UpdateParam) []Code {
+	if p.Args.UpdateMap != nil {
+		return []Code{
+			Id("columns").Op(":=").Index().String().Values(),
+			Id("args").Op(":=").Index().Interface().Values(),
+		}
+	}
+	return []Code{
+		If(p.Args.UpdateValue().Op("==").Nil()).Block(Return(Nil())),
+		Id("args").Op(":=").Index().Interface().Values(),
+	}
+}
+
+// updateColumns returns codes to append SET clauses and their values to columns and args.
+// if `dao.update_map` is enabled, they are specified by keys of map. otherwise helper generated by UpdateColumns is called.
+// nothing is updated if no column is specified, because query without SET clause is syntax error.
+// member with lock_version cannot be specified, and it is incremented if other columns are updated.
+func updateColumns(p *types.UpdateParam) []Code {
+	var code Code
+	if p.Args.UpdateMap != nil {
+		code = updateColumnsByMap(p)
+	} else {
+		code = List(Id("columns"), Id("args")).Op(":=").Add(p.Receiver().Dot(UpdateColumnsMethodName).Call(p.Args.UpdateValue(), Id("args")))
+	}
+	codes := []Code{
+		code,
+		If(Len(Id("columns")).Op("==").Lit(0)).Block(Return(Nil())),
+	}
+	if p.LockVersionMember != nil {
+		// version is incremented like Update because other columns are changed without checking it
+		column := p.Dialect.Quote(p.LockVersionMember.Name.SnakeName())
+		codes = append(codes, Id("columns").Op("=").Append(Id("columns"), Lit(fmt.Sprintf("%s = %s + 1", column, column))))
+	}
+	return codes
+}
+
+// updateColumnsByMap returns code to append SET clauses and their values by updateMap.
+// keys of updateMap are converted to column names, and unknown key returns UnknownColumnError not to embed it in query.
+func updateColumnsByMap(p *types.UpdateParam) Code {
+	body := UpdateMapColumn(p)
+	if p.Dialect.IsNumberedPlaceholder() {
+		body = append(body,
+			Id("args").Op("=").Append(Id("args"), Id("v")),
+			Id("columns").Op("=").Append(
+				Id("columns"),
+				Qual(p.Package("fmt"), "Sprintf").Call(Lit(fmt.Sprintf("%s = $%%d", p.Dialect.QuoteFormat())), Id("column"), Len(Id("args"))),
+			),
+		)
+	} else {
+		body = append(body,
+			Id("columns").Op("=").Append(Id("columns"), Qual(p.Package("fmt"), "Sprintf").Call(Lit(fmt.Sprintf("%s = ?", p.Dialect.QuoteFormat())), Id("column"))),
+			Id("args").Op("=").Append(Id("args"), Id("v")),
+		)
+	}
+	return For(
+		List(Id("key"), Id("v")).Op(":=").Range().Add(p.Args.UpdateMap()),
+	).Block(body...)
+}
+
+// UpdateMapColumn returns codes to convert key of updateMap to column name like the following code.
+// key is accepted as column name, member name in camel case or render name.
+// ===========================================================
+// var column string
+// switch key {
+// case "name", "Name": column = "name"
+// default: return &UnknownColumnError{Table: "users", Column: key}
+// }
+// ===========================================================
+func UpdateMapColumn(p *types.UpdateParam) []Code {
+	cases := []Code{}
+	for _, member := range p.Class.UpdateColumnMembers() {
+		keys := []Code{}
+		for _, key := range member.UpdateMapKeys() {
+			keys = append(keys, Lit(key))
+		}
+		cases = append(cases, Case(keys...).Block(Id("column").Op("=").Lit(member.Name.SnakeName())))
+	}
+	cases = append(cases, Default().Block(
+		Return(Op("&").Id(UnknownColumnErrorName).Values(Dict{
+			Id("Table"):  Lit(p.Class.Name.PluralSnakeName()),
+			Id("Column"): Id("key"),
+		})),
+	))
+	return []Code{
+		Var().Id("column").String(),
+		Switch(Id("key")).Block(cases...),
+	}
+}
+
+// placeholder returns code to create bind variable for last element of args
+func placeholder(p types.DataAccessParam) Code {
+	if p.Dialect.IsNumberedPlaceholder() {
+		return Qual(p.Package("fmt"), "Sprintf").Call(Lit("$%d"), Len(Id("args")))
+	}
+	return Lit("?")
+}
+
+// clauseWithPlaceholder returns code to create clause whose value is bound to last element of args ( e.g. LIMIT ? )
+func clauseWithPlaceholder(p types.DataAccessParam, clause string) Code {
+	if p.Dialect.IsNumberedPlaceholder() {
+		return Qual(p.Package("fmt"), "Sprintf").Call(Lit(clause+" $%d"), Len(Id("args")))
+	}
+	return Lit(clause + " ?")
+}
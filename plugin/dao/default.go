@@ -18,6 +18,18 @@ func (*DefaultPlugin) CreateDeclare(d *types.MethodDeclare) error           { re
 func (*DefaultPlugin) Create(*types.CreateParam) []Code                     { return []Code{} }
 func (*DefaultPlugin) BeforeCreate(*types.CreateParam) []Code               { return []Code{} }
 func (*DefaultPlugin) AfterCreate(*types.CreateParam) []Code                { return []Code{} }
+func (*DefaultPlugin) CreateMultiDeclare(d *types.MethodDeclare) error      { return nil }
+func (*DefaultPlugin) CreateMulti(*types.CreateMultiParam) []Code           { return []Code{} }
+func (*DefaultPlugin) BeforeCreateMulti(*types.CreateMultiParam) []Code     { return []Code{} }
+func (*DefaultPlugin) AfterCreateMulti(*types.CreateMultiParam) []Code      { return []Code{} }
+func (*DefaultPlugin) UpsertDeclare(d *types.MethodDeclare) error           { return nil }
+func (*DefaultPlugin) Upsert(*types.UpsertParam) []Code                     { return []Code{} }
+func (*DefaultPlugin) BeforeUpsert(*types.UpsertParam) []Code               { return []Code{} }
+func (*DefaultPlugin) AfterUpsert(*types.UpsertParam) []Code                { return []Code{} }
+func (*DefaultPlugin) UpsertMultiDeclare(d *types.MethodDeclare) error      { return nil }
+func (*DefaultPlugin) UpsertMulti(*types.UpsertMultiParam) []Code           { return []Code{} }
+func (*DefaultPlugin) BeforeUpsertMulti(*types.UpsertMultiParam) []Code     { return []Code{} }
+func (*DefaultPlugin) AfterUpsertMulti(*types.UpsertMultiParam) []Code      { return []Code{} }
 func (*DefaultPlugin) UpdateDeclare(d *types.MethodDeclare) error           { return nil }
 func (*DefaultPlugin) Update(*types.UpdateParam) []Code                     { return []Code{} }
 func (*DefaultPlugin) BeforeUpdate(*types.UpdateParam) []Code               { return []Code{} }
@@ -34,6 +46,10 @@ func (*DefaultPlugin) CountDeclare(d *types.MethodDeclare) error            { re
 func (*DefaultPlugin) Count(*types.CountParam) []Code                       { return []Code{} }
 func (*DefaultPlugin) BeforeCount(p *types.CountParam) []Code               { return []Code{} }
 func (*DefaultPlugin) AfterCount(p *types.CountParam) []Code                { return []Code{} }
+func (*DefaultPlugin) QueryDeclare(d *types.MethodDeclare) error            { return nil }
+func (*DefaultPlugin) Query(*types.QueryParam) []Code                       { return []Code{} }
+func (*DefaultPlugin) BeforeQuery(p *types.QueryParam) []Code               { return []Code{} }
+func (*DefaultPlugin) AfterQuery(p *types.QueryParam) []Code                { return []Code{} }
 func (*DefaultPlugin) FindByDeclare(d *types.MethodDeclare) error           { return nil }
 func (*DefaultPlugin) FindBy(*types.FindParam) []Code                       { return []Code{} }
 func (*DefaultPlugin) BeforeFindBy(p *types.FindParam) []Code               { return []Code{} }
@@ -58,3 +74,9 @@ func (*DefaultPlugin) DeleteByPluralDeclare(d *types.MethodDeclare) error   { re
 func (*DefaultPlugin) DeleteByPlural(*types.DeleteParam) []Code             { return []Code{} }
 func (*DefaultPlugin) BeforeDeleteByPlural(p *types.DeleteParam) []Code     { return []Code{} }
 func (*DefaultPlugin) AfterDeleteByPlural(p *types.DeleteParam) []Code      { return []Code{} }
+func (*DefaultPlugin) UpdateColumns(p *types.UpdateParam) []Code            { return []Code{} }
+func (*DefaultPlugin) Dialect() types.Dialect                               { return "" }
+func (*DefaultPlugin) HasTable() bool                                       { return false }
+func (*DefaultPlugin) SupportsOptimisticLock() bool                         { return false }
+func (*DefaultPlugin) SupportsLockingRead() bool                            { return false }
+func (*DefaultPlugin) SupportsQuery() bool                                  { return false }
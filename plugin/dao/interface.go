@@ -24,6 +24,30 @@ const (
 	CreatePlugin = "create"
 	// AfterCreatePlugin name for hook of AfterCreate
 	AfterCreatePlugin = "after-create"
+	// CreateMultiDeclarePlugin name for hook of CreateMultiDeclare
+	CreateMultiDeclarePlugin = "create-multi-declare"
+	// BeforeCreateMultiPlugin name for hook of BeforeCreateMulti
+	BeforeCreateMultiPlugin = "before-create-multi"
+	// CreateMultiPlugin name for hook of CreateMulti
+	CreateMultiPlugin = "create-multi"
+	// AfterCreateMultiPlugin name for hook of AfterCreateMulti
+	AfterCreateMultiPlugin = "after-create-multi"
+	// UpsertDeclarePlugin name for hook of UpsertDeclare
+	UpsertDeclarePlugin = "upsert-declare"
+	// BeforeUpsertPlugin name for hook of BeforeUpsert
+	BeforeUpsertPlugin = "before-upsert"
+	// UpsertPlugin name for hook of Upsert
+	UpsertPlugin = "upsert"
+	// AfterUpsertPlugin name for hook of AfterUpsert
+	AfterUpsertPlugin = "after-upsert"
+	// UpsertMultiDeclarePlugin name for hook of UpsertMultiDeclare
+	UpsertMultiDeclarePlugin = "upsert-multi-declare"
+	// BeforeUpsertMultiPlugin name for hook of BeforeUpsertMulti
+	BeforeUpsertMultiPlugin = "before-upsert-multi"
+	// UpsertMultiPlugin name for hook of UpsertMulti
+	UpsertMultiPlugin = "upsert-multi"
+	// AfterUpsertMultiPlugin name for hook of AfterUpsertMulti
+	AfterUpsertMultiPlugin = "after-upsert-multi"
 	// UpdateDeclarePlugin name for hook of UpdateDeclare
 	UpdateDeclarePlugin = "update-declare"
 	// BeforeUpdatePlugin name for hook of BeforeUpdate
@@ -56,6 +80,14 @@ const (
 	CountPlugin = "count"
 	// AfterCountPlugin name for hook of AfterCount
 	AfterCountPlugin = "after-count"
+	// QueryDeclarePlugin name for hook of QueryDeclare
+	QueryDeclarePlugin = "query-declare"
+	// BeforeQueryPlugin name for hook of BeforeQuery
+	BeforeQueryPlugin = "before-query"
+	// QueryPlugin name for hook of Query
+	QueryPlugin = "query"
+	// AfterQueryPlugin name for hook of AfterQuery
+	AfterQueryPlugin = "after-query"
 	// FindByDeclarePlugin name for hook of FindByDeclare
 	FindByDeclarePlugin = "findby-declare"
 	// BeforeFindByPlugin name for hook of BeforeFindBy
@@ -106,6 +138,17 @@ const (
 	AfterDeleteByPluralPlugin = "after-deleteby-plural"
 )
 
+// UnknownColumnErrorName name of error type returned by UpdateBy methods for unknown key of updateMap.
+// it is generated in dao package if `dao.update_map` is enabled.
+const UnknownColumnErrorName = "UnknownColumnError"
+
+// StaleObjectErrorName name of error returned by Update when version of record was changed by other transaction.
+// it is generated in dao package if any class has member with lock_version.
+const StaleObjectErrorName = "ErrStaleObject"
+
+// UpdateColumnsMethodName name of helper method generated for datastore whose UpdateColumns returns codes.
+const UpdateColumnsMethodName = "updateColumns"
+
 type DataStorePlugin interface {
 	// StructFields hook definition of data accessor structure
 	StructFields(*types.Class, types.StructFieldList) types.StructFieldList
@@ -117,6 +160,12 @@ type DataStorePlugin interface {
 	Constructor(*types.ConstructorParam) []Code
 	// Create exec insert query to database in default
 	Create(*types.CreateParam) []Code
+	// CreateMulti exec multi-row insert query to database in default
+	CreateMulti(*types.CreateMultiParam) []Code
+	// Upsert exec insert query that updates existing record to database in default
+	Upsert(*types.UpsertParam) []Code
+	// UpsertMulti exec multi-row insert query that updates existing records to database in default
+	UpsertMulti(*types.UpsertMultiParam) []Code
 	// Update exec update query with 'where id = ?' to database in default
 	Update(*types.UpdateParam) []Code
 	// Delete exec delete query with 'where id = ?' to database in default
@@ -125,6 +174,8 @@ type DataStorePlugin interface {
 	FindAll(*types.FindParam) []Code
 	// Count exec count query to database in default
 	Count(*types.CountParam) []Code
+	// Query exec select query declared in class file to database in default
+	Query(*types.QueryParam) []Code
 	// FindBy exec select query to database in default
 	FindBy(*types.FindParam) []Code
 	// FindByPlural exec select query to database in default
@@ -137,6 +188,19 @@ type DataStorePlugin interface {
 	DeleteBy(*types.DeleteParam) []Code
 	// DeleteByPlural exec delete query to database in default
 	DeleteByPlural(*types.DeleteParam) []Code
+	// UpdateColumns body of helper method that returns SET clauses and their values built from types.UpdateParamArgs.UpdateValue.
+	// helper is generated once per class and called by UpdateBy and UpdateByPlural only if it returns codes
+	UpdateColumns(*types.UpdateParam) []Code
+	// Dialect SQL dialect for generated query. if it returns empty, dialect in configuration file is used
+	Dialect() types.Dialect
+	// HasTable whether records of class are stored in table of RDBMS. schema and migration files are generated only if it returns true
+	HasTable() bool
+	// SupportsOptimisticLock whether Update checks version by types.UpdateParam.LockVersionMember. class can have member with lock_version only if it returns true
+	SupportsOptimisticLock() bool
+	// SupportsLockingRead whether FindBy takes row lock by types.FindParamArgs.Lock. class can enable `locking_read` only if it returns true
+	SupportsLockingRead() bool
+	// SupportsQuery whether Query execs types.QueryParam.SQL. class can declare `queries` only if it returns true
+	SupportsQuery() bool
 }
 
 type DAOPlugin interface {
@@ -147,6 +211,24 @@ type DAOPlugin interface {
 	BeforeCreate(*types.CreateParam) []Code
 	// AfterCreate insert some codes in 'defer' function for Create
 	AfterCreate(*types.CreateParam) []Code
+	// CreateMultiDeclare hook declaration for CreateMulti interface
+	CreateMultiDeclare(*types.MethodDeclare) error
+	// BeforeCreateMulti insert some codes as first statement for CreateMulti
+	BeforeCreateMulti(*types.CreateMultiParam) []Code
+	// AfterCreateMulti insert some codes in 'defer' function for CreateMulti
+	AfterCreateMulti(*types.CreateMultiParam) []Code
+	// UpsertDeclare hook declaration for Upsert interface
+	UpsertDeclare(*types.MethodDeclare) error
+	// BeforeUpsert insert some codes as first statement for Upsert
+	BeforeUpsert(*types.UpsertParam) []Code
+	// AfterUpsert insert some codes in 'defer' function for Upsert
+	AfterUpsert(*types.UpsertParam) []Code
+	// UpsertMultiDeclare hook declaration for UpsertMulti interface
+	UpsertMultiDeclare(*types.MethodDeclare) error
+	// BeforeUpsertMulti insert some codes as first statement for UpsertMulti
+	BeforeUpsertMulti(*types.UpsertMultiParam) []Code
+	// AfterUpsertMulti insert some codes in 'defer' function for UpsertMulti
+	AfterUpsertMulti(*types.UpsertMultiParam) []Code
 	// UpdateDeclare hook declaration for Update interface
 	UpdateDeclare(*types.MethodDeclare) error
 	// BeforeUpdate insert some codes as first statement for Update
@@ -171,6 +253,12 @@ type DAOPlugin interface {
 	BeforeCount(*types.CountParam) []Code
 	// AfterCount insert some codes in 'defer' function for Count
 	AfterCount(*types.CountParam) []Code
+	// QueryDeclare hook declaration for Query interface
+	QueryDeclare(*types.MethodDeclare) error
+	// BeforeQuery insert some codes as first statement for Query
+	BeforeQuery(*types.QueryParam) []Code
+	// AfterQuery insert some codes in 'defer' function for Query
+	AfterQuery(*types.QueryParam) []Code
 	// FindByDeclare hook declaration for FindBy interface
 	FindByDeclare(*types.MethodDeclare) error
 	// BeforeFindBy insert some codes as first statement for FindBy
@@ -245,6 +333,15 @@ func DataStoreMap(name string) (DAOPluginMap, bool) {
 		CreatePlugin: func(c types.DAOContext) ([]Code, error) {
 			return datastore.Create(c.(*types.CreateParam)), nil
 		},
+		CreateMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return datastore.CreateMulti(c.(*types.CreateMultiParam)), nil
+		},
+		UpsertPlugin: func(c types.DAOContext) ([]Code, error) {
+			return datastore.Upsert(c.(*types.UpsertParam)), nil
+		},
+		UpsertMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return datastore.UpsertMulti(c.(*types.UpsertMultiParam)), nil
+		},
 		UpdatePlugin: func(c types.DAOContext) ([]Code, error) {
 			return datastore.Update(c.(*types.UpdateParam)), nil
 		},
@@ -257,6 +354,9 @@ func DataStoreMap(name string) (DAOPluginMap, bool) {
 		CountPlugin: func(c types.DAOContext) ([]Code, error) {
 			return datastore.Count(c.(*types.CountParam)), nil
 		},
+		QueryPlugin: func(c types.DAOContext) ([]Code, error) {
+			return datastore.Query(c.(*types.QueryParam)), nil
+		},
 		FindByPlugin: func(c types.DAOContext) ([]Code, error) {
 			return datastore.FindBy(c.(*types.FindParam)), nil
 		},
@@ -302,6 +402,42 @@ func PluginMap(name string) (DAOPluginMap, bool) {
 		AfterCreatePlugin: func(c types.DAOContext) ([]Code, error) {
 			return plugin.AfterCreate(c.(*types.CreateParam)), nil
 		},
+		CreateMultiDeclarePlugin: func(c types.DAOContext) ([]Code, error) {
+			return nil, plugin.CreateMultiDeclare(c.(*types.MethodDeclare))
+		},
+		BeforeCreateMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.BeforeCreateMulti(c.(*types.CreateMultiParam)), nil
+		},
+		CreateMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.CreateMulti(c.(*types.CreateMultiParam)), nil
+		},
+		AfterCreateMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.AfterCreateMulti(c.(*types.CreateMultiParam)), nil
+		},
+		UpsertDeclarePlugin: func(c types.DAOContext) ([]Code, error) {
+			return nil, plugin.UpsertDeclare(c.(*types.MethodDeclare))
+		},
+		BeforeUpsertPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.BeforeUpsert(c.(*types.UpsertParam)), nil
+		},
+		UpsertPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.Upsert(c.(*types.UpsertParam)), nil
+		},
+		AfterUpsertPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.AfterUpsert(c.(*types.UpsertParam)), nil
+		},
+		UpsertMultiDeclarePlugin: func(c types.DAOContext) ([]Code, error) {
+			return nil, plugin.UpsertMultiDeclare(c.(*types.MethodDeclare))
+		},
+		BeforeUpsertMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.BeforeUpsertMulti(c.(*types.UpsertMultiParam)), nil
+		},
+		UpsertMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.UpsertMulti(c.(*types.UpsertMultiParam)), nil
+		},
+		AfterUpsertMultiPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.AfterUpsertMulti(c.(*types.UpsertMultiParam)), nil
+		},
 		UpdateDeclarePlugin: func(c types.DAOContext) ([]Code, error) {
 			return nil, plugin.UpdateDeclare(c.(*types.MethodDeclare))
 		},
@@ -350,6 +486,18 @@ func PluginMap(name string) (DAOPluginMap, bool) {
 		AfterCountPlugin: func(c types.DAOContext) ([]Code, error) {
 			return plugin.AfterCount(c.(*types.CountParam)), nil
 		},
+		QueryDeclarePlugin: func(c types.DAOContext) ([]Code, error) {
+			return nil, plugin.QueryDeclare(c.(*types.MethodDeclare))
+		},
+		BeforeQueryPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.BeforeQuery(c.(*types.QueryParam)), nil
+		},
+		QueryPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.Query(c.(*types.QueryParam)), nil
+		},
+		AfterQueryPlugin: func(c types.DAOContext) ([]Code, error) {
+			return plugin.AfterQuery(c.(*types.QueryParam)), nil
+		},
 		FindByDeclarePlugin: func(c types.DAOContext) ([]Code, error) {
 			return nil, plugin.FindByDeclare(c.(*types.MethodDeclare))
 		},
@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"go.knocknote.io/eevee/types"
+)
+
+// SQLiteDataStore datastore for SQLite.
+// Generated code is the same as DBDataStore except for SQL dialect,
+// so it is useful to run DAO with in-memory database in tests.
+type SQLiteDataStore struct {
+	DBDataStore
+}
+
+func init() {
+	RegisterDataStore("sqlite", &SQLiteDataStore{})
+}
+
+func (*SQLiteDataStore) Dialect() types.Dialect {
+	return types.DialectSQLite
+}
+
+// SupportsLockingRead SQLite doesn't support row lock, so `locking_read` is rejected instead of generating methods that lock nothing
+func (*SQLiteDataStore) SupportsLockingRead() bool {
+	return false
+}
@@ -24,6 +24,10 @@ func (*RapidashDataStore) Imports(pkgs types.ImportList) types.ImportList {
 			Path: "go.knocknote.io/rapidash",
 			Name: "rapidash",
 		},
+		{
+			Path: "sort",
+			Name: "sort",
+		},
 		{
 			Path: "golang.org/x/xerrors",
 			Name: "xerrors",
@@ -75,17 +79,52 @@ func (*RapidashDataStore) Constructor(p *types.ConstructorParam) []Code {
 	}
 }
 
+// HasTable rapidash caches records of table in RDBMS
+func (*RapidashDataStore) HasTable() bool {
+	return true
+}
+
+// Dialect rapidash uses dialect in configuration file
+func (*RapidashDataStore) Dialect() types.Dialect {
+	return ""
+}
+
+// SupportsOptimisticLock rapidash doesn't check version of record by Update
+func (*RapidashDataStore) SupportsOptimisticLock() bool {
+	return false
+}
+
+// SupportsLockingRead rapidash reads records through cache, so it cannot take row lock
+func (*RapidashDataStore) SupportsLockingRead() bool {
+	return false
+}
+
+// SupportsQuery rapidash cannot exec SQL declared in class file
+func (*RapidashDataStore) SupportsQuery() bool {
+	return false
+}
+
+// UpdateColumns rapidash builds updated values in each UpdateBy method, so helper method isn't generated
+func (*RapidashDataStore) UpdateColumns(*types.UpdateParam) []Code {
+	return nil
+}
+
 func (*RapidashDataStore) Create(p *types.CreateParam) []Code {
-	var idMember *types.Member
-	for _, member := range p.Class.Members {
-		if member.Relation != nil {
-			continue
-		}
-		if member.Extend {
-			continue
-		}
-		if member.Name.SnakeName() == "id" {
-			idMember = member
+	autoIncrementMember := p.Class.AutoIncrementMember()
+	if autoIncrementMember == nil {
+		// primary key is specified by application ( e.g. UUID or composite primary key )
+		return []Code{
+			If(
+				List(Id("_"), Err()).Op(":=").Add(p.Field("tx").Dot("CreateByTableContext").Call(
+					Id("ctx"),
+					Lit(p.Class.Name.PluralSnakeName()),
+					Id("value"),
+				)),
+				Err().Op("!=").Nil(),
+			).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Create: %w"), Err())),
+			),
+			Return(Nil()),
 		}
 	}
 	return []Code{
@@ -97,11 +136,127 @@ func (*RapidashDataStore) Create(p *types.CreateParam) []Code {
 		If(Err().Op("!=").Nil()).Block(
 			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Create: %w"), Err())),
 		),
-		Id("value").Dot("ID").Op("=").Add(idMember.Type.Code(p.ImportList)).Call(Id("id")), // TODO: need to AUTO_INCREMENT column only
+		Id("value").Dot(autoIncrementMember.Name.CamelName()).Op("=").Add(autoIncrementMember.Type.Code(p.ImportList)).Call(Id("id")),
+		Return(Nil()),
+	}
+}
+
+// CreateMulti rapidash doesn't support multi-row INSERT, so it inserts values one by one
+func (*RapidashDataStore) CreateMulti(p *types.CreateMultiParam) []Code {
+	autoIncrementMember := p.Class.AutoIncrementMember()
+	create := p.Field("tx").Dot("CreateByTableContext").Call(
+		Id("ctx"),
+		Lit(p.Class.Name.PluralSnakeName()),
+		p.Args.Value(),
+	)
+	var body []Code
+	if autoIncrementMember == nil {
+		// primary key is specified by application ( e.g. UUID or composite primary key )
+		body = []Code{
+			If(List(Id("_"), Err()).Op(":=").Add(create), Err().Op("!=").Nil()).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Create: %w"), Err())),
+			),
+		}
+	} else {
+		body = []Code{
+			List(Id("id"), Err()).Op(":=").Add(create),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Create: %w"), Err())),
+			),
+			p.Args.Value().Dot(autoIncrementMember.Name.CamelName()).Op("=").Add(autoIncrementMember.Type.Code(p.ImportList)).Call(Id("id")),
+		}
+	}
+	return []Code{
+		For(List(Id("_"), p.Args.Value()).Op(":=").Range().Add(p.Args.Values())).Block(body...),
+		Return(Nil()),
+	}
+}
+
+// Upsert rapidash doesn't support upsert query, so it finds record by conflict key in the transaction and updates or creates it
+func (*RapidashDataStore) Upsert(p *types.UpsertParam) []Code {
+	builder := Qual(p.Package("rapidash"), "NewQueryBuilder").Call(Lit(p.Class.Name.PluralSnakeName()))
+	conflictMemberMap := map[string]struct{}{}
+	for _, member := range p.ConflictMembers {
+		builder = builder.Dot("Eq").Call(Lit(member.Name.SnakeName()), Id("value").Dot(member.Name.CamelName()))
+		conflictMemberMap[member.Name.SnakeName()] = struct{}{}
+	}
+	autoIncrementMember := p.Class.AutoIncrementMember()
+	updateMap := Dict{}
+	for _, member := range p.Class.ColumnMembers() {
+		if _, exists := conflictMemberMap[member.Name.SnakeName()]; exists {
+			continue
+		}
+		if member.AutoIncrement {
+			continue
+		}
+		updateMap[Lit(member.Name.SnakeName())] = Id("value").Dot(member.Name.CamelName())
+	}
+	updateBlock := []Code{}
+	if autoIncrementMember != nil {
+		updateBlock = append(updateBlock,
+			Id("value").Dot(autoIncrementMember.Name.CamelName()).Op("=").
+				Id("values").Index(Lit(0)).Dot(autoIncrementMember.Name.CamelName()),
+		)
+	}
+	if len(updateMap) > 0 {
+		updateBlock = append(updateBlock,
+			If(Err().Op(":=").Add(p.Field("tx").Dot("UpdateByQueryBuilderContext").Call(
+				Id("ctx"),
+				Id("builder"),
+				Map(String()).Interface().Values(updateMap),
+			)), Err().Op("!=").Nil()).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Update: %w"), Err())),
+			),
+		)
+	}
+	updateBlock = append(updateBlock, Return(Nil()))
+	return []Code{
+		Id("builder").Op(":=").Add(builder),
+		Var().Id("values").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()),
+		If(
+			Err().Op(":=").Add(p.Field("tx").Dot("FindByQueryBuilderContext").Call(
+				Id("ctx"), Id("builder"), Op("&").Id("values"),
+			)),
+			Err().Op("!=").Nil(),
+		).Block(
+			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Find: %w"), Err())),
+		),
+		If(Len(Id("values")).Op(">").Lit(0)).Block(updateBlock...),
+		If(Err().Op(":=").Add(p.Receiver().Dot("Create").Call(Id("ctx"), Id("value"))), Err().Op("!=").Nil()).Block(
+			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Create: %w"), Err())),
+		),
 		Return(Nil()),
 	}
 }
 
+// UpsertMulti upserts values one by one by Upsert
+func (*RapidashDataStore) UpsertMulti(p *types.UpsertMultiParam) []Code {
+	return []Code{
+		For(List(Id("_"), p.Args.Value()).Op(":=").Range().Add(p.Args.Values())).Block(
+			If(Err().Op(":=").Add(p.Receiver().Dot("Upsert").Call(p.Args.Context(), p.Args.Value())), Err().Op("!=").Nil()).Block(
+				Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Upsert: %w"), Err())),
+			),
+		),
+		Return(Nil()),
+	}
+}
+
+// primaryKeyQueryBuilder generate like the following code
+// ===========================================================
+// builder := rapidash.NewQueryBuilder("users").Eq("id", value.ID)
+// ===========================================================
+func primaryKeyQueryBuilder(class *types.Class, rapidashPackage string) Code {
+	builder := Qual(rapidashPackage, "NewQueryBuilder").Call(Lit(class.Name.PluralSnakeName()))
+	primaryKeys := class.PrimaryKeys()
+	if len(primaryKeys) == 0 {
+		return Id("builder").Op(":=").Add(builder.Dot("Eq").Call(Lit("id"), Id("value").Dot("ID")))
+	}
+	for _, member := range primaryKeys {
+		builder = builder.Dot("Eq").Call(Lit(member.Name.SnakeName()), Id("value").Dot(member.Name.CamelName()))
+	}
+	return Id("builder").Op(":=").Add(builder)
+}
+
 func (*RapidashDataStore) Update(p *types.UpdateParam) []Code {
 	updateMap := Dict{}
 	for _, member := range p.Class.Members {
@@ -111,16 +266,14 @@ func (*RapidashDataStore) Update(p *types.UpdateParam) []Code {
 		if member.Extend {
 			continue
 		}
-		if member.Name.SnakeName() == "id" {
+		if p.Class.Index.PrimaryKey.Contains(member.Name.SnakeName()) || member.Name.SnakeName() == "id" {
 			continue
 		}
 		updateMap[Lit(member.Name.SnakeName())] = Id("value").Dot(member.Name.CamelName())
 	}
 	return []Code{
 		Id("updateMap").Op(":=").Map(String()).Interface().Values(updateMap),
-		Id("builder").Op(":=").Qual(p.Package("rapidash"), "NewQueryBuilder").Call(
-			Lit(p.Class.Name.PluralSnakeName()),
-		).Dot("Eq").Call(Lit("id"), Id("value").Dot("ID")),
+		primaryKeyQueryBuilder(p.Class, p.Package("rapidash")),
 		If(Err().Op(":=").Add(p.Field("tx").Dot("UpdateByQueryBuilderContext").Call(
 			Id("ctx"),
 			Id("builder"),
@@ -134,9 +287,7 @@ func (*RapidashDataStore) Update(p *types.UpdateParam) []Code {
 
 func (*RapidashDataStore) Delete(p *types.DeleteParam) []Code {
 	return []Code{
-		Id("builder").Op(":=").Qual(p.Package("rapidash"), "NewQueryBuilder").Call(
-			Lit(p.Class.Name.PluralSnakeName()),
-		).Dot("Eq").Call(Lit("id"), Id("value").Dot("ID")),
+		primaryKeyQueryBuilder(p.Class, p.Package("rapidash")),
 		If(
 			Err().Op(":=").Add(p.Field("tx").Dot("DeleteByQueryBuilderContext").Call(Id("ctx"), Id("builder"))),
 			Err().Op("!=").Nil(),
@@ -148,7 +299,7 @@ func (*RapidashDataStore) Delete(p *types.DeleteParam) []Code {
 }
 
 func (*RapidashDataStore) FindAll(p *types.FindParam) []Code {
-	return []Code{
+	codes := []Code{
 		Id("values").Op(":=").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()).Block(),
 		If(
 			Err().Op(":=").Add(p.Field("tx").Dot("FindAllByTable").Call(Lit(p.Class.Name.PluralSnakeName()), Op("&").Id("values"))),
@@ -156,8 +307,11 @@ func (*RapidashDataStore) FindAll(p *types.FindParam) []Code {
 		).Block(
 			Return(List(Id("values"), Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to FindAll %w"), Err()))),
 		),
-		Return(List(Id("values"), Nil())),
 	}
+	if p.Args.Option != nil {
+		return append(codes, findOptionCodes(p)...)
+	}
+	return append(codes, Return(List(Id("values"), Nil())))
 }
 
 func (*RapidashDataStore) Count(p *types.CountParam) []Code {
@@ -173,13 +327,40 @@ func (*RapidashDataStore) Count(p *types.CountParam) []Code {
 	}
 }
 
+// Query rapidash cannot exec SQL declared in class file.
+// it isn't called because SupportsQuery returns false, and classes declaring queries fail to generate.
+func (*RapidashDataStore) Query(p *types.QueryParam) []Code {
+	return []Code{
+		Return(List(Nil(), Qual(p.Package("xerrors"), "New").Call(Lit(fmt.Sprintf("rapidash doesn't support query %s declared in class file", p.Query.Name))))),
+	}
+}
+
+// rangeBuilderMethodMap methods of rapidash.QueryBuilder for each range operator
+var rangeBuilderMethodMap = map[types.RangeOperator][]string{
+	types.RangeBetween:            {"Gte", "Lte"},
+	types.RangeGreaterThan:        {"Gt"},
+	types.RangeGreaterThanOrEqual: {"Gte"},
+	types.RangeLessThan:           {"Lt"},
+	types.RangeLessThanOrEqual:    {"Lte"},
+}
+
 func (*RapidashDataStore) FindBy(p *types.FindParam) []Code {
 	builder := Id("builder").Op(":=").Qual(p.Package("rapidash"), "NewQueryBuilder").Call(
 		Lit(p.Class.Name.PluralSnakeName()),
 	)
-	for idx, member := range p.Args.Members {
+	members := p.Args.Members
+	if p.Args.Range != "" {
+		members = members[:len(members)-1]
+	}
+	for idx, member := range members {
 		builder = builder.Dot("Eq").Call(Lit(member.Name.SnakeName()), Id(fmt.Sprintf("a%d", idx)))
 	}
+	if p.Args.Range != "" {
+		lastMember := p.Args.Members[len(p.Args.Members)-1]
+		for idx, method := range rangeBuilderMethodMap[p.Args.Range] {
+			builder = builder.Dot(method).Call(Lit(lastMember.Name.SnakeName()), Id(fmt.Sprintf("a%d", len(members)+idx)))
+		}
+	}
 	if p.IsSingleReturnValue {
 		return []Code{
 			builder,
@@ -195,7 +376,7 @@ func (*RapidashDataStore) FindBy(p *types.FindParam) []Code {
 			Return(Op("&").Id("value"), Nil()),
 		}
 	}
-	return []Code{
+	codes := []Code{
 		builder,
 		Var().Id("values").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()),
 		If(
@@ -206,8 +387,11 @@ func (*RapidashDataStore) FindBy(p *types.FindParam) []Code {
 		).Block(
 			Return(Nil(), Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Find: %w"), Err())),
 		),
-		Return(Id("values"), Nil()),
 	}
+	if p.Args.Option != nil {
+		return append(codes, findOptionCodes(p)...)
+	}
+	return append(codes, Return(Id("values"), Nil()))
 }
 
 func (*RapidashDataStore) FindByPlural(p *types.FindParam) []Code {
@@ -217,7 +401,7 @@ func (*RapidashDataStore) FindByPlural(p *types.FindParam) []Code {
 	for idx, member := range p.Args.Members {
 		builder = builder.Dot("In").Call(Lit(member.Name.SnakeName()), Id(fmt.Sprintf("a%d", idx)))
 	}
-	return []Code{
+	codes := []Code{
 		builder,
 		Id("values").Op(":=").Qual(p.Package("entity"), p.Class.Name.PluralCamelName()).Values(),
 		If(
@@ -228,10 +412,118 @@ func (*RapidashDataStore) FindByPlural(p *types.FindParam) []Code {
 		).Block(
 			Return(Nil(), Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Find: %w"), Err())),
 		),
+	}
+	if p.Args.Option != nil {
+		return append(codes, findOptionCodes(p)...)
+	}
+	return append(codes, Return(Id("values"), Nil()))
+}
+
+// lessCode returns code to compare member of a and b.
+func lessCode(member *types.Member) Code {
+	a := Id("a").Dot(member.Name.CamelName())
+	b := Id("b").Dot(member.Name.CamelName())
+	if member.Type.Type.IsTime() {
+		return a.Dot("Before").Call(b)
+	}
+	return a.Op("<").Add(b)
+}
+
+// notEqualCode returns code to check that member of a and b are different.
+func notEqualCode(member *types.Member) Code {
+	a := Id("a").Dot(member.Name.CamelName())
+	b := Id("b").Dot(member.Name.CamelName())
+	if member.Type.Type.IsTime() {
+		return Op("!").Add(a).Dot("Equal").Call(b)
+	}
+	return a.Op("!=").Add(b)
+}
+
+// findOptionCodes returns codes to sort found values and cut out range of them specified by option.
+// rapidash doesn't support ORDER BY and LIMIT, so they are processed in application.
+// primary key is used to decide order of records which have the same value.
+func findOptionCodes(p *types.FindParam) []Code {
+	opt := p.Args.Option
+	value := Op("*").Qual(p.Package("entity"), p.Class.Name.CamelName())
+	primaryKey := p.Class.PrimaryKeys()
+	cases := []Code{}
+	for _, member := range p.Class.OrderMembers() {
+		members := types.Members{member}
+		for _, m := range primaryKey {
+			if m != member {
+				members = append(members, m)
+			}
+		}
+		body := []Code{}
+		for _, m := range members[:len(members)-1] {
+			body = append(body, If(notEqualCode(m)).Block(Return(lessCode(m))))
+		}
+		body = append(body, Return(lessCode(members[len(members)-1])))
+		values := []Code{Qual(p.Package("entity"), p.Class.OrderColumnName(member))}
+		if member == primaryKey[0] {
+			values = append([]Code{Lit("")}, values...)
+		}
+		cases = append(cases, Case(values...).Block(
+			Id("less").Op("=").Func().Params(Id("a"), Id("b").Add(value)).Bool().Block(body...),
+		))
+	}
+	cases = append(cases, Default().Block(
+		Return(Nil(), Qual(p.Package("xerrors"), "Errorf").Call(
+			Lit(fmt.Sprintf("cannot sort %s by %%s", p.Class.Name.PluralSnakeName())), opt().Dot("OrderBy"),
+		)),
+	))
+	return []Code{
+		If(opt().Op("==").Nil()).Block(
+			opt().Op("=").Op("&").Qual(p.Package("entity"), p.Class.FindOptionStructName()).Values(),
+		),
+		Var().Id("less").Func().Params(Id("a"), Id("b").Add(value)).Bool(),
+		Switch(opt().Dot("OrderBy")).Block(cases...),
+		If(opt().Dot("Desc")).Block(
+			Id("asc").Op(":=").Id("less"),
+			Id("less").Op("=").Func().Params(Id("a"), Id("b").Add(value)).Bool().Block(
+				Return(Id("asc").Call(Id("b"), Id("a"))),
+			),
+		),
+		Qual(p.Package("sort"), "Slice").Call(Id("values"), Func().Params(Id("i"), Id("j").Int()).Bool().Block(
+			Return(Id("less").Call(Id("values").Index(Id("i")), Id("values").Index(Id("j")))),
+		)),
+		If(opt().Dot("After").Op("!=").Nil()).Block(
+			Id("values").Op("=").Id("values").Index(
+				Qual(p.Package("sort"), "Search").Call(Len(Id("values")), Func().Params(Id("i").Int()).Bool().Block(
+					Return(Id("less").Call(opt().Dot("After"), Id("values").Index(Id("i")))),
+				)).Op(":"),
+			),
+		),
+		If(opt().Dot("Offset").Op(">=").Len(Id("values"))).Block(
+			Return(Qual(p.Package("entity"), p.Class.Name.PluralCamelName()).Values(), Nil()),
+		),
+		Id("values").Op("=").Id("values").Index(opt().Dot("Offset").Op(":")),
+		If(opt().Dot("Limit").Op(">").Lit(0).Op("&&").Add(opt().Dot("Limit")).Op("<").Len(Id("values"))).Block(
+			Id("values").Op("=").Id("values").Index(Op(":").Add(opt().Dot("Limit"))),
+		),
 		Return(Id("values"), Nil()),
 	}
 }
 
+// columnMapCodes returns codes to create columnMap passed to rapidash.
+// it is created from fields of entity ( e.g. *entity.UserUpdate ) that are not nil.
+// if `dao.update_map` is enabled, it is created from updateMap whose keys are converted to column names.
+func columnMapCodes(p *types.UpdateParam) []Code {
+	codes := []Code{Id("columnMap").Op(":=").Map(String()).Interface().Values()}
+	if p.Args.UpdateMap != nil {
+		body := dao.UpdateMapColumn(p)
+		body = append(body, Id("columnMap").Index(Id("column")).Op("=").Id("v"))
+		return append(codes, For(List(Id("key"), Id("v")).Op(":=").Range().Add(p.Args.UpdateMap())).Block(body...))
+	}
+	for _, member := range p.Class.UpdateColumnMembers() {
+		field := p.Args.UpdateValue().Dot(member.Name.CamelName())
+		codes = append(codes, If(field.Clone().Op("!=").Nil()).Block(
+			Id("columnMap").Index(Lit(member.Name.SnakeName())).Op("=").Op("*").Add(field),
+		))
+	}
+	return codes
+}
+
 func (*RapidashDataStore) UpdateBy(p *types.UpdateParam) []Code {
 	builder := Id("builder").Op(":=").Qual(p.Package("rapidash"), "NewQueryBuilder").Call(
 		Lit(p.Class.Name.PluralSnakeName()),
@@ -239,18 +531,18 @@ func (*RapidashDataStore) UpdateBy(p *types.UpdateParam) []Code {
 	for idx, member := range p.Args.Members {
 		builder = builder.Dot("Eq").Call(Lit(member.Name.SnakeName()), Id(fmt.Sprintf("a%d", idx)))
 	}
-	return []Code{
+	return append(columnMapCodes(p),
 		builder,
 		If(
 			Err().Op(":=").Add(p.Field("tx").Dot("UpdateByQueryBuilderContext").Call(
-				Id("ctx"), Id("builder"), Id("updateMap"),
+				Id("ctx"), Id("builder"), Id("columnMap"),
 			)),
 			Err().Op("!=").Nil(),
 		).Block(
 			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Update: %w"), Err())),
 		),
 		Return(Nil()),
-	}
+	)
 }
 
 func (*RapidashDataStore) UpdateByPlural(p *types.UpdateParam) []Code {
@@ -260,18 +552,18 @@ func (*RapidashDataStore) UpdateByPlural(p *types.UpdateParam) []Code {
 	for idx, member := range p.Args.Members {
 		builder = builder.Dot("In").Call(Lit(member.Name.SnakeName()), Id(fmt.Sprintf("a%d", idx)))
 	}
-	return []Code{
+	return append(columnMapCodes(p),
 		builder,
 		If(
 			Err().Op(":=").Add(p.Field("tx").Dot("UpdateByQueryBuilderContext").Call(
-				Id("ctx"), Id("builder"), Id("updateMap"),
+				Id("ctx"), Id("builder"), Id("columnMap"),
 			)),
 			Err().Op("!=").Nil(),
 		).Block(
 			Return(Qual(p.Package("xerrors"), "Errorf").Call(Lit("failed to Update: %w"), Err())),
 		),
 		Return(Nil()),
-	}
+	)
 }
 
 func (*RapidashDataStore) DeleteBy(p *types.DeleteParam) []Code {
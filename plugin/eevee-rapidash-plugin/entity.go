@@ -88,10 +88,14 @@ func (h *RapidashEntityHandler) EncodeRapidash(helper *types.EntityMethodHelper)
 			)
 			continue
 		}
+		value := helper.Field(member.Name.CamelName())
+		if member.Type.IsCustomPrimitiveType() {
+			value = Id(member.Type.Type.As).Call(value)
+		}
 		codes = append(codes,
 			Id("enc").Dot(member.CamelType()).Call(
 				Lit(member.Name.SnakeName()),
-				helper.Field(member.Name.CamelName()),
+				value,
 			),
 		)
 	}
@@ -163,10 +167,14 @@ func (h *RapidashEntityHandler) DecodeRapidash(helper *types.EntityMethodHelper)
 		if member.Extend {
 			continue
 		}
+		value := Id("dec").Dot(member.CamelType()).Call(Lit(member.Name.SnakeName()))
+		if member.Type.IsCustomPrimitiveType() {
+			value = Parens(member.Type.CodePackage("entity", helper.ImportList)).Call(value)
+		}
 		codes = append(codes,
 			helper.Field(member.Name.CamelName()).
 				Op("=").
-				Id("dec").Dot(member.CamelType()).Call(Lit(member.Name.SnakeName())),
+				Add(value),
 		)
 	}
 	codes = append(codes, []Code{
@@ -14,52 +14,80 @@ func init() {
 	dao.Register("request-time", &DAORequestTimePlugin{})
 }
 
-func (*DAORequestTimePlugin) BeforeCreate(p *types.CreateParam) []Code {
-	createdAt := p.Class.MemberByName("created_at")
-	updatedAt := p.Class.MemberByName("updated_at")
-	if createdAt == nil || updatedAt == nil {
-		return nil
-	}
-	code := []Code{
+// requestTimeCode gets requestTime from ctx.Value(`REQUEST_TIME`)
+func requestTimeCode(h CodeHelper) []Code {
+	return []Code{
 		Id("v").Op(":=").Id("ctx").Dot("Value").Call(Lit("REQUEST_TIME")),
-		List(Id("requestTime"), Id("ok")).Op(":=").Id("v").Assert(Qual(p.Package("time"), "Time")),
+		List(Id("requestTime"), Id("ok")).Op(":=").Id("v").Assert(Qual(h.Package("time"), "Time")),
 		If(
 			Add(Op("!")).Id("ok"),
 		).Block(
-			Return(Qual(p.Package("xerrors"), "New").Call(Lit("cannot convert time.Time value from ctx.Value(`REQUEST_TIME`)"))),
+			Return(Qual(h.Package("xerrors"), "New").Call(Lit("cannot convert time.Time value from ctx.Value(`REQUEST_TIME`)"))),
 		),
 	}
-	if createdAt.Type.IsPointer {
-		code = append(code, Id("value").Dot("CreatedAt").Op("=").Op("&").Id("requestTime"))
-	} else {
-		code = append(code, Id("value").Dot("CreatedAt").Op("=").Id("requestTime"))
-	}
-	if updatedAt.Type.IsPointer {
-		code = append(code, Id("value").Dot("UpdatedAt").Op("=").Op("&").Id("requestTime"))
-	} else {
-		code = append(code, Id("value").Dot("UpdatedAt").Op("=").Id("requestTime"))
+}
+
+// assignRequestTime assigns requestTime to fields of members in value
+func assignRequestTime(value func() *Statement, members ...*types.Member) []Code {
+	code := []Code{}
+	for _, member := range members {
+		if member.Type.IsPointer {
+			code = append(code, value().Dot(member.Name.CamelName()).Op("=").Op("&").Id("requestTime"))
+		} else {
+			code = append(code, value().Dot(member.Name.CamelName()).Op("=").Id("requestTime"))
+		}
 	}
 	return code
 }
 
-func (*DAORequestTimePlugin) BeforeUpdate(p *types.UpdateParam) []Code {
+func (*DAORequestTimePlugin) BeforeCreate(p *types.CreateParam) []Code {
+	createdAt := p.Class.CreatedAtMember()
 	updatedAt := p.Class.MemberByName("updated_at")
-	if updatedAt == nil {
+	if createdAt == nil || updatedAt == nil {
 		return nil
 	}
-	code := []Code{
-		Id("v").Op(":=").Id("ctx").Dot("Value").Call(Lit("REQUEST_TIME")),
-		List(Id("requestTime"), Id("ok")).Op(":=").Id("v").Assert(Qual(p.Package("time"), "Time")),
-		If(
-			Add(Op("!")).Id("ok"),
-		).Block(
-			Return(Qual(p.Package("xerrors"), "New").Call(Lit("cannot convert time.Time value from ctx.Value(`REQUEST_TIME`)"))),
+	return append(requestTimeCode(p), assignRequestTime(p.Args.Value, createdAt, updatedAt)...)
+}
+
+func (*DAORequestTimePlugin) BeforeCreateMulti(p *types.CreateMultiParam) []Code {
+	createdAt := p.Class.CreatedAtMember()
+	updatedAt := p.Class.MemberByName("updated_at")
+	if createdAt == nil || updatedAt == nil {
+		return nil
+	}
+	return append(requestTimeCode(p),
+		For(List(Id("_"), p.Args.Value()).Op(":=").Range().Add(p.Args.Values())).Block(
+			assignRequestTime(p.Args.Value, createdAt, updatedAt)...,
 		),
+	)
+}
+
+func (*DAORequestTimePlugin) BeforeUpsert(p *types.UpsertParam) []Code {
+	createdAt := p.Class.CreatedAtMember()
+	updatedAt := p.Class.MemberByName("updated_at")
+	if createdAt == nil || updatedAt == nil {
+		return nil
+	}
+	return append(requestTimeCode(p), assignRequestTime(p.Args.Value, createdAt, updatedAt)...)
+}
+
+func (*DAORequestTimePlugin) BeforeUpsertMulti(p *types.UpsertMultiParam) []Code {
+	createdAt := p.Class.CreatedAtMember()
+	updatedAt := p.Class.MemberByName("updated_at")
+	if createdAt == nil || updatedAt == nil {
+		return nil
 	}
-	if updatedAt.Type.IsPointer {
-		code = append(code, Id("value").Dot("UpdatedAt").Op("=").Op("&").Id("requestTime"))
-	} else {
-		code = append(code, Id("value").Dot("UpdatedAt").Op("=").Id("requestTime"))
+	return append(requestTimeCode(p),
+		For(List(Id("_"), p.Args.Value()).Op(":=").Range().Add(p.Args.Values())).Block(
+			assignRequestTime(p.Args.Value, createdAt, updatedAt)...,
+		),
+	)
+}
+
+func (*DAORequestTimePlugin) BeforeUpdate(p *types.UpdateParam) []Code {
+	updatedAt := p.Class.MemberByName("updated_at")
+	if updatedAt == nil {
+		return nil
 	}
-	return code
+	return append(requestTimeCode(p), assignRequestTime(p.Args.Value, updatedAt)...)
 }
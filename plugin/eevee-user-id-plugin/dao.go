@@ -2,6 +2,7 @@ package eevee
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	. "go.knocknote.io/eevee/code"
@@ -58,6 +59,15 @@ func (h *DAOUserIDPlugin) newArgs(d *types.MethodDeclare) ([]string, types.Value
 	return argsCamelNames, args
 }
 
+// isPositionalArg whether argument is named by position like a0, a1
+func (h *DAOUserIDPlugin) isPositionalArg(arg *types.ValueDeclare) bool {
+	if !strings.HasPrefix(arg.Name, "a") {
+		return false
+	}
+	_, err := strconv.Atoi(strings.TrimPrefix(arg.Name, "a"))
+	return err == nil
+}
+
 func (h *DAOUserIDPlugin) StructFields(class *types.Class, fields types.StructFieldList) types.StructFieldList {
 	values := types.ValueDeclares{
 		{
@@ -129,11 +139,26 @@ func (h *DAOUserIDPlugin) FindByDeclare(d *types.MethodDeclare) error {
 		return nil
 	}
 	argsCamelNames, args := h.newArgs(d)
-	if len(argsCamelNames) == 0 {
-		d.MethodName = "FindAll"
-	} else {
-		d.MethodName = fmt.Sprintf("FindBy%s", strings.Join(argsCamelNames, "And"))
+	methodName := "FindAll"
+	if len(argsCamelNames) > 0 {
+		methodName = fmt.Sprintf("FindBy%s", strings.Join(argsCamelNames, "And"))
+	}
+	// suffix of method name and arguments following members ( e.g. upper bound of BETWEEN or option ) are kept
+	memberNames := []string{}
+	for _, member := range d.ArgMembers {
+		memberNames = append(memberNames, member.Name.CamelName())
+	}
+	methodName += strings.TrimPrefix(d.MethodName, fmt.Sprintf("FindBy%s", strings.Join(memberNames, "And")))
+	for _, arg := range d.Args[len(d.ArgMembers)+1:] {
+		if h.isPositionalArg(arg) {
+			arg = &types.ValueDeclare{
+				Name: fmt.Sprintf("a%d", len(args)-1),
+				Type: arg.Type,
+			}
+		}
+		args = append(args, arg)
 	}
+	d.MethodName = methodName
 	d.Args = args
 	return nil
 }
@@ -145,8 +170,12 @@ func (h *DAOUserIDPlugin) FindBy(p *types.FindParam) []Code {
 	if p.Args.Members[0].Name.SnakeName() != "user_id" {
 		return h.DataStore.FindBy(p)
 	}
-	queryArgs := []Code{Code(p.Args.Context()), Id("query"), p.Field("userID")}
 	p.SQL.Args = p.SQL.Args[:len(p.SQL.Args)-1] // remove argument for userID
+	if p.Args.Option != nil {
+		p.SQL.Args = append([]Code{p.Field("userID")}, p.SQL.Args...)
+		return h.DataStore.FindBy(p)
+	}
+	queryArgs := []Code{Code(p.Args.Context()), Id("query"), p.Field("userID")}
 	queryArgs = append(queryArgs, p.SQL.Args...)
 	return h.DataStore.FindWithQueryArgs(p, queryArgs)
 }
@@ -156,14 +185,8 @@ func (h *DAOUserIDPlugin) UpdateByDeclare(d *types.MethodDeclare) error {
 		return nil
 	}
 	argsCamelNames, args := h.newArgs(d)
-	args = append(args, &types.ValueDeclare{
-		Name: "updateMap",
-		Type: &types.TypeDeclare{
-			Type: &types.Type{
-				Name: "map[string]interface{}",
-			},
-		},
-	})
+	// last argument specifies updated columns ( entity or map[string]interface{} )
+	args = append(args, d.Args[len(d.Args)-1])
 	d.MethodName = fmt.Sprintf("UpdateBy%s", strings.Join(argsCamelNames, "And"))
 	d.Args = args
 	return nil
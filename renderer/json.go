@@ -96,21 +96,33 @@ func (r *JSONRenderer) appendFloatCode(h RendererHelper, member *types.Member) C
 // appendStringCode generate like the following code
 // ===========================================================
 // buf = strconv.Append(buf, strconv.Quote(value)...)
+// or
+// buf = strconv.Append(buf, strconv.Quote(string(value))...)
 // ===========================================================
 func (r *JSONRenderer) appendStringCode(h RendererHelper, member *types.Member) Code {
+	typ := member.Type.Type
+	var value *Statement
+	if member.Type.IsPointer {
+		value = Op("*").Add(h.Field(member.Name.CamelName()))
+	} else {
+		value = h.Field(member.Name.CamelName())
+	}
+	if typ.Name != types.StringType.Name {
+		value = Id("string").Call(value)
+	}
 	if member.Type.IsPointer {
 		return If(h.Field(member.Name.CamelName()).Op("==").Nil()).Block(
 			Id("buf").Op("=").Append(Id("buf"), Lit("null").Op("...")),
 		).Else().Block(
 			Id("buf").Op("=").Append(
 				Id("buf"),
-				Qual("strconv", "Quote").Call(Op("*").Add(h.Field(member.Name.CamelName()))).Op("..."),
+				Qual("strconv", "Quote").Call(value).Op("..."),
 			),
 		)
 	}
 	return Id("buf").Op("=").Append(
 		Id("buf"),
-		Qual("strconv", "Quote").Call(h.Field(member.Name.CamelName())).Op("..."),
+		Qual("strconv", "Quote").Call(value).Op("..."),
 	)
 }
 
@@ -236,24 +248,33 @@ func (r *JSONRenderer) appendClassCode(h RendererHelper, member *types.Member, w
 	return codes
 }
 
-func (r *JSONRenderer) appendMember(h RendererHelper, member *types.Member, withOption bool) []Code {
-	def := Id("buf").Op("=").Append(Id("buf"), Lit(`"`+member.RenderNameByProtocol("json")+`":`).Op("..."))
+func (r *JSONRenderer) appendPrimitiveCode(h RendererHelper, member *types.Member) Code {
 	typ := member.Type.Type
 	switch {
 	case typ.IsInt():
-		return []Code{def, r.appendIntCode(h, member)}
+		return r.appendIntCode(h, member)
 	case typ.IsUint():
-		return []Code{def, r.appendUintCode(h, member)}
+		return r.appendUintCode(h, member)
 	case typ.IsFloat():
-		return []Code{def, r.appendFloatCode(h, member)}
+		return r.appendFloatCode(h, member)
 	case typ.IsString():
-		return []Code{def, r.appendStringCode(h, member)}
+		return r.appendStringCode(h, member)
 	case typ.IsByte():
-		return []Code{def, r.appendBytesCode(h, member)}
+		return r.appendBytesCode(h, member)
 	case typ.IsBool():
-		return []Code{def, r.appendBoolCode(h, member)}
+		return r.appendBoolCode(h, member)
 	case typ.IsTime():
-		return []Code{def, r.appendTimeCode(h, member)}
+		return r.appendTimeCode(h, member)
+	}
+	return nil
+}
+
+func (r *JSONRenderer) appendMember(h RendererHelper, member *types.Member, withOption bool) []Code {
+	def := Id("buf").Op("=").Append(Id("buf"), Lit(`"`+member.RenderNameByProtocol("json")+`":`).Op("..."))
+	if code := r.appendPrimitiveCode(h, member); code != nil {
+		return []Code{def, code}
+	}
+	switch {
 	case member.Relation != nil:
 		return r.appendRelationCode(h, member, withOption)
 	case member.Type.Class() != nil:
@@ -262,6 +283,42 @@ func (r *JSONRenderer) appendMember(h RendererHelper, member *types.Member, with
 	return []Code{}
 }
 
+// appendCustomPrimitiveMember generate like the following code
+// ===========================================================
+// buf = append(buf, "\"name\":"...)
+// if marshaler, ok := interface{}(value).(json.Marshaler); ok {
+//   bytes, err := marshaler.MarshalJSON()
+//   if err != nil {
+//     return nil, err
+//   }
+//   buf = append(buf, bytes...)
+// } else {
+//   buf = strconv.Append(buf, strconv.Quote(string(value))...)
+// }
+// ===========================================================
+func (r *JSONRenderer) appendCustomPrimitiveMember(h RendererHelper, member *types.Member) []Code {
+	def := Id("buf").Op("=").Append(Id("buf"), Lit(`"`+member.RenderNameByProtocol("json")+`":`).Op("..."))
+	elseBlock := []Code{}
+	if code := r.appendPrimitiveCode(h, member); code != nil {
+		elseBlock = append(elseBlock, code)
+	}
+	return []Code{
+		def,
+		If(
+			List(Id("marshaler"), Id("ok")).Op(":=").Add(
+				Interface().Parens(h.Field(member.Name.CamelName())).Assert(Qual(h.Package("json"), "Marshaler")),
+			),
+			Id("ok"),
+		).Block(
+			List(Id("bytes"), Err()).Op(":=").Id("marshaler").Dot("MarshalJSON").Call(),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Nil(), WrapError(h, "failed to MarshalJSON: %w")),
+			),
+			Id("buf").Op("=").Append(Id("buf"), Id("bytes").Op("...")),
+		).Else().Block(elseBlock...),
+	}
+}
+
 func (r *JSONRenderer) Render(h RendererHelper) *types.Method {
 	decl := h.CreateMethodDeclare()
 	decl.MethodName = "ToJSON"
@@ -308,20 +365,7 @@ func (r *JSONRenderer) Render(h RendererHelper) *types.Method {
 			codes = append(codes, Id("buf").Op("=").Append(Id("buf"), LitRune(',')))
 		}
 		if member.Type.IsCustomPrimitiveType() {
-			codes = append(codes,
-				If(
-					List(Id("marshaler"), Id("ok")).Op(":=").Add(
-						Interface().Parens(h.Field(member.Name.CamelName())).Assert(Qual(h.Package("json"), "Marshaler")),
-					),
-					Id("ok"),
-				).Block(
-					List(Id("bytes"), Err()).Op(":=").Id("marshaler").Dot("MarshalJSON").Call(),
-					If(Err().Op("!=").Nil()).Block(
-						Return(Nil(), WrapError(h, "failed to MarshalJSON: %w")),
-					),
-					Id("buf").Op("=").Append(Id("buf"), Id("bytes").Op("...")),
-				).Else().Block(r.appendMember(h, member, false)...),
-			)
+			codes = append(codes, r.appendCustomPrimitiveMember(h, member)...)
 		} else {
 			codes = append(codes, r.appendMember(h, member, false)...)
 		}
@@ -412,20 +456,7 @@ func (r *JSONRenderer) RenderWithOption(h RendererHelper) *types.Method {
 			}...)
 		} else {
 			if member.Type.IsCustomPrimitiveType() {
-				blocks = append(blocks,
-					If(
-						List(Id("marshaler"), Id("ok")).Op(":=").Add(
-							Interface().Parens(h.Field(member.Name.CamelName())).Assert(Qual(h.Package("json"), "Marshaler")),
-						),
-						Id("ok"),
-					).Block(
-						List(Id("bytes"), Err()).Op(":=").Id("marshaler").Dot("MarshalJSON").Call(),
-						If(Err().Op("!=").Nil()).Block(
-							Return(Nil(), WrapError(h, "failed to MarshalJSON: %w")),
-						),
-						Id("buf").Op("=").Append(Id("buf"), Id("bytes").Op("...")),
-					).Else().Block(r.appendMember(h, member, false)...),
-				)
+				blocks = append(blocks, r.appendCustomPrimitiveMember(h, member)...)
 			} else {
 				blocks = append(blocks, r.appendMember(h, member, false)...)
 			}
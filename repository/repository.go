@@ -2,7 +2,6 @@ package repository
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
@@ -17,24 +16,31 @@ import (
 )
 
 type Generator struct {
-	packageName    string
-	receiverName   string
-	daoPath        string
-	importList     types.ImportList
-	constructorMap map[*types.Class]*types.ConstructorDeclare
-	cfg            *config.Config
+	packageName  string
+	receiverName string
+	daoPath      string
+	importList   types.ImportList
+	cfg          *config.Config
 }
 
 func NewGenerator(cfg *config.Config) *Generator {
 	return &Generator{
-		packageName:    cfg.RepositoryPackageName(),
-		receiverName:   "r",
-		importList:     types.DefaultImportList(cfg.ModulePath, cfg.ContextImportPath()),
-		constructorMap: map[*types.Class]*types.ConstructorDeclare{},
-		cfg:            cfg,
+		packageName:  cfg.RepositoryPackageName(),
+		receiverName: "r",
+		importList:   types.DefaultImportList(cfg.ModulePath, cfg.ContextImportPath()),
+		daoPath:      daoPath(cfg.OutputPathWithPackage(cfg.RepositoryPackageName())),
+		cfg:          cfg,
 	}
 }
 
+// daoPath returns path of dao package placed at the same level as path
+func daoPath(path string) string {
+	splittedPaths := strings.Split(path, string(filepath.Separator))
+	daoPaths := splittedPaths[:len(splittedPaths)-1]
+	daoPaths = append(daoPaths, "dao")
+	return filepath.Join(daoPaths...)
+}
+
 func (g *Generator) helper(class *types.Class) *types.RepositoryMethodHelper {
 	return &types.RepositoryMethodHelper{
 		AppName:      g.cfg.ModulePath,
@@ -44,17 +50,7 @@ func (g *Generator) helper(class *types.Class) *types.RepositoryMethodHelper {
 	}
 }
 
-func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
-	f := code.NewFile(g.packageName)
-	f.HeaderComment(code.GeneratedMarker)
-	for _, importDeclare := range g.importList {
-		f.ImportName(importDeclare.Path, importDeclare.Name)
-	}
-	daoGenerator := dao.NewGenerator(g.cfg)
-	daoPackageDecl, err := daoGenerator.PackageDeclare(class, g.daoPath)
-	if err != nil {
-		return nil, xerrors.Errorf("cannot create package declaration for dao(%s): %w", class.Name.SnakeName(), err)
-	}
+func (g *Generator) subClassConstructorMap(daoGenerator *dao.Generator, class *types.Class) (map[*types.Class]*types.ConstructorDeclare, error) {
 	subClassConstructorMap := map[*types.Class]*types.ConstructorDeclare{}
 	for _, member := range class.RelationMembers() {
 		relation := member.Relation
@@ -71,6 +67,42 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 		}
 		subClassConstructorMap[subClass] = pkgDecl.Constructor
 	}
+	return subClassConstructorMap, nil
+}
+
+// constructorMap returns declarations of constructor for each class
+func (g *Generator) constructorMap(classes []*types.Class) (map[*types.Class]*types.ConstructorDeclare, error) {
+	constructorMap := map[*types.Class]*types.ConstructorDeclare{}
+	for _, class := range classes {
+		daoGenerator := dao.NewGenerator(g.cfg)
+		daoPackageDecl, err := daoGenerator.PackageDeclare(class, g.daoPath)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create package declaration for dao(%s): %w", class.Name.SnakeName(), err)
+		}
+		subClassConstructorMap, err := g.subClassConstructorMap(daoGenerator, class)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot get constructors of related classes: %w", err)
+		}
+		constructor, _ := g.Constructor(g.helper(class), daoPackageDecl.Constructor, subClassConstructorMap)
+		constructorMap[class] = constructor
+	}
+	return constructorMap, nil
+}
+
+func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
+	f := code.NewFile(g.packageName)
+	for _, importDeclare := range g.importList {
+		f.ImportName(importDeclare.Path, importDeclare.Name)
+	}
+	daoGenerator := dao.NewGenerator(g.cfg)
+	daoPackageDecl, err := daoGenerator.PackageDeclare(class, g.daoPath)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create package declaration for dao(%s): %w", class.Name.SnakeName(), err)
+	}
+	subClassConstructorMap, err := g.subClassConstructorMap(daoGenerator, class)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get constructors of related classes: %w", err)
+	}
 	toModelMethod := g.ToModel(g.helper(class))
 	toModelsMethod := g.ToModels(g.helper(class))
 	interfaceBody := []code.Code{
@@ -95,6 +127,17 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 		createMethods = append(createMethods, g.Creates(g.helper(class)))
 		methodNameMap["Create"] = struct{}{}
 		methodNameMap["Creates"] = struct{}{}
+		for _, method := range daoPackageDecl.Methods {
+			switch method.MethodName {
+			case "Upsert":
+				createMethods = append(createMethods, g.Upsert(g.helper(class)))
+			case "UpsertMulti":
+				createMethods = append(createMethods, g.UpsertMulti(g.helper(class)))
+			default:
+				continue
+			}
+			methodNameMap[method.MethodName] = struct{}{}
+		}
 		for _, method := range daoPackageDecl.Methods {
 			if !strings.HasPrefix(method.MethodName, "UpdateBy") {
 				continue
@@ -153,8 +196,7 @@ func (g *Generator) generate(class *types.Class, path string) ([]byte, error) {
 		code.GoType().Id(fmt.Sprintf("%sImpl", class.Name.CamelName())).Struct(structFields...),
 	)
 	f.Line()
-	constructor, block := g.Constructor(g.helper(class), daoPackageDecl.Constructor, subClassConstructorMap)
-	g.constructorMap[constructor.Class] = constructor
+	_, block := g.Constructor(g.helper(class), daoPackageDecl.Constructor, subClassConstructorMap)
 	f.Add(block)
 	f.Line()
 	f.Add(toModelMethod.Generate(g.importList))
@@ -240,6 +282,18 @@ func (g *Generator) generateMock(class *types.Class, path string) ([]byte, error
 		"Create":  {},
 		"Creates": {},
 	}
+	upsertMethods := []*types.Method{}
+	for _, method := range daoPackageDecl.Methods {
+		switch method.MethodName {
+		case "Upsert":
+			upsertMethods = append(upsertMethods, g.UpsertMock(g.helper(class)))
+		case "UpsertMulti":
+			upsertMethods = append(upsertMethods, g.UpsertMultiMock(g.helper(class)))
+		default:
+			continue
+		}
+		methodNameMap[method.MethodName] = struct{}{}
+	}
 	findMethods := []*types.Method{}
 	for _, method := range daoPackageDecl.Methods {
 		if !strings.HasPrefix(method.MethodName, "Find") {
@@ -277,6 +331,9 @@ func (g *Generator) generateMock(class *types.Class, path string) ([]byte, error
 		toModelMethod.Decl.Interface(g.importList),
 		toModelsMethod.Decl.Interface(g.importList),
 	}
+	for _, mtd := range upsertMethods {
+		interfaceBody = append(interfaceBody, mtd.Decl.Interface(g.importList))
+	}
 	for _, mtd := range findMethods {
 		interfaceBody = append(interfaceBody, mtd.Decl.Interface(g.importList))
 	}
@@ -309,6 +366,7 @@ func (g *Generator) generateMock(class *types.Class, path string) ([]byte, error
 	}
 	expectFields := []code.Code{}
 	expectValues := code.Dict{}
+	methods = append(methods, upsertMethods...)
 	methods = append(methods, findMethods...)
 	methods = append(methods, updateByMethods...)
 	methods = append(methods, deleteByMethods...)
@@ -339,10 +397,10 @@ func (g *Generator) generateMock(class *types.Class, path string) ([]byte, error
 	return source, nil
 }
 
-func (g *Generator) generateRepositoryClass(path string, classes []*types.Class) error {
+func (g *Generator) generateRepositoryClass(path string, classes []*types.Class, constructorMap map[*types.Class]*types.ConstructorDeclare) error {
 	allArgs := types.ValueDeclares{}
-	for _, constructor := range g.constructorMap {
-		for _, arg := range constructor.Args {
+	for _, class := range classes {
+		for _, arg := range constructorMap[class].Args {
 			allArgs = append(allArgs, arg)
 		}
 	}
@@ -360,7 +418,7 @@ func (g *Generator) generateRepositoryClass(path string, classes []*types.Class)
 	fields := []code.Code{}
 	interfaceFields := []code.Code{}
 	for _, class := range classes {
-		constructor := g.constructorMap[class]
+		constructor := constructorMap[class]
 		className := class.Name.CamelName()
 		classLowerName := class.Name.CamelLowerName()
 		variables = append(variables, code.Id(classLowerName).Op("*").Id(fmt.Sprintf("%sImpl", className)))
@@ -432,22 +490,22 @@ func (g *Generator) generateRepositoryClass(path string, classes []*types.Class)
 	source := []byte(fmt.Sprintf("%#v", f))
 	repositoryGoPath := filepath.Join(path, "repository.go")
 	if g.existsFile(repositoryGoPath) {
-		if err := os.Remove(repositoryGoPath); err != nil {
+		if err := g.cfg.OutputWriter().Remove(repositoryGoPath); err != nil {
 			return xerrors.Errorf("failed to remove file %s: %w", repositoryGoPath, err)
 		}
 	}
-	if err := ioutil.WriteFile(repositoryGoPath, source, 0444); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(repositoryGoPath, source, 0444); err != nil {
 		return xerrors.Errorf("cannot write file to %s: %w", path, err)
 	}
 	return nil
 }
 
-func (g *Generator) generateRepositoryMockClass(path string, classes []*types.Class) error {
+func (g *Generator) generateRepositoryMockClass(path string, classes []*types.Class, constructorMap map[*types.Class]*types.ConstructorDeclare) error {
 	variables := []code.Code{code.Id("repo").Op("*").Id("RepositoryMock")}
 	properties := code.Dict{}
 	fields := []code.Code{}
 	for _, class := range classes {
-		constructor := g.constructorMap[class]
+		constructor := constructorMap[class]
 		className := class.Name.CamelName()
 		classLowerName := class.Name.CamelLowerName()
 		variables = append(variables, code.Id(classLowerName).Op("*").Id(fmt.Sprintf("%sMock", className)))
@@ -512,66 +570,99 @@ func (g *Generator) generateRepositoryMockClass(path string, classes []*types.Cl
 	source := []byte(fmt.Sprintf("%#v", f))
 	repositoryGoPath := filepath.Join(path, "repository.go")
 	if g.existsFile(repositoryGoPath) {
-		if err := os.Remove(repositoryGoPath); err != nil {
+		if err := g.cfg.OutputWriter().Remove(repositoryGoPath); err != nil {
 			return xerrors.Errorf("failed to remove file %s: %w", repositoryGoPath, err)
 		}
 	}
-	if err := ioutil.WriteFile(repositoryGoPath, source, 0444); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(repositoryGoPath, source, 0444); err != nil {
 		return xerrors.Errorf("cannot write file to %s: %w", path, err)
 	}
 	return nil
 }
 
 func (g *Generator) existsFile(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
+	return g.cfg.OutputWriter().Exists(path)
 }
 
-func (g *Generator) writeFile(class *types.Class, basePath string, source []byte) error {
+func (g *Generator) writeFile(class *types.Class, basePath string, source []byte, perm os.FileMode) error {
 	path := filepath.Join(basePath, fmt.Sprintf("%s.go", class.Name.SnakeName()))
 	if g.existsFile(path) {
-		if err := os.Remove(path); err != nil {
+		if err := g.cfg.OutputWriter().Remove(path); err != nil {
 			return xerrors.Errorf("failed to remove file %s: %w", path, err)
 		}
 	}
-	if err := ioutil.WriteFile(path, source, 0444); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(path, source, perm); err != nil {
 		return xerrors.Errorf("cannot write file %s: %w", path, err)
 	}
 	return nil
 }
 
-func (g *Generator) Generate(classes []*types.Class) error {
+func (g *Generator) mockPath() string {
+	return filepath.Join(g.cfg.OutputPath, "mock", g.packageName)
+}
+
+// GenerateClass generates repository and mock of repository for class
+func (g *Generator) GenerateClass(class *types.Class) error {
 	path := g.cfg.OutputPathWithPackage(g.packageName)
-	if err := os.MkdirAll(path, 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(path); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", path, err)
 	}
-	splittedPaths := strings.Split(path, string(filepath.Separator))
-	daoPaths := splittedPaths[:len(splittedPaths)-1]
-	daoPaths = append(daoPaths, "dao")
-	daoPath := filepath.Join(daoPaths...)
-	g.daoPath = daoPath
+	mockPath := g.mockPath()
+	if err := g.cfg.OutputWriter().MkdirAll(mockPath); err != nil {
+		return xerrors.Errorf("cannot create directory to %s: %w", mockPath, err)
+	}
+	source, err := g.generate(class, path)
+	if err != nil {
+		return xerrors.Errorf("cannot generate repository for %s: %w", class.Name.SnakeName(), err)
+	}
+	source, err = code.MergeCustomizedFile(g.cfg.OutputWriter(), filepath.Join(path, fmt.Sprintf("%s.go", class.Name.SnakeName())), source)
+	if err != nil {
+		return xerrors.Errorf("cannot merge repository for %s: %w", class.Name.SnakeName(), err)
+	}
+	if err := g.writeFile(class, path, source, 0644); err != nil {
+		return xerrors.Errorf("cannot write file for %s: %w", class.Name.SnakeName(), err)
+	}
+	mockSource, err := g.generateMock(class, mockPath)
+	if err != nil {
+		return xerrors.Errorf("cannot generate repository for %s: %w", class.Name.SnakeName(), err)
+	}
+	if err := g.writeFile(class, mockPath, mockSource, 0444); err != nil {
+		return xerrors.Errorf("cannot write file for %s: %w", class.Name.SnakeName(), err)
+	}
+	return nil
+}
 
-	mockPath := filepath.Join(g.cfg.OutputPath, "mock", g.packageName)
-	if err := os.MkdirAll(mockPath, 0755); err != nil {
+// GeneratePackage generates repository.go and mock of it depending on all classes
+func (g *Generator) GeneratePackage(classes []*types.Class) error {
+	path := g.cfg.OutputPathWithPackage(g.packageName)
+	if err := g.cfg.OutputWriter().MkdirAll(path); err != nil {
+		return xerrors.Errorf("cannot create directory to %s: %w", path, err)
+	}
+	mockPath := g.mockPath()
+	if err := g.cfg.OutputWriter().MkdirAll(mockPath); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", mockPath, err)
 	}
+	constructorMap, err := g.constructorMap(classes)
+	if err != nil {
+		return xerrors.Errorf("cannot get constructors: %w", err)
+	}
+	if err := g.generateRepositoryClass(path, classes, constructorMap); err != nil {
+		return xerrors.Errorf("cannot generate repository.go: %w", err)
+	}
+	if err := g.generateRepositoryMockClass(mockPath, classes, constructorMap); err != nil {
+		return xerrors.Errorf("cannot generate repository.go for mock: %w", err)
+	}
+	return nil
+}
+
+func (g *Generator) Generate(classes []*types.Class) error {
 	for _, class := range classes {
-		source, err := g.generate(class, path)
-		if err != nil {
+		if err := g.GenerateClass(class); err != nil {
 			return xerrors.Errorf("cannot generate repository for %s: %w", class.Name.SnakeName(), err)
 		}
-		if err := g.writeFile(class, path, source); err != nil {
-			return xerrors.Errorf("cannot write file for %s: %w", class.Name.SnakeName(), err)
-		}
-		mockSource, err := g.generateMock(class, mockPath)
-		if err != nil {
-			return xerrors.Errorf("cannot generate repository for %s: %w", class.Name.SnakeName(), err)
-		}
-		if err := g.writeFile(class, mockPath, mockSource); err != nil {
-			return xerrors.Errorf("cannot write file for %s: %w", class.Name.SnakeName(), err)
-		}
 	}
-	g.generateRepositoryClass(path, classes)
-	g.generateRepositoryMockClass(mockPath, classes)
+	if err := g.GeneratePackage(classes); err != nil {
+		return xerrors.Errorf("cannot generate package: %w", err)
+	}
 	return nil
 }
@@ -1,11 +1,17 @@
 package repository_test
 
 import (
+	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"go.knocknote.io/eevee/class"
+	"go.knocknote.io/eevee/code"
 	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/dao"
+	"go.knocknote.io/eevee/model"
+	"go.knocknote.io/eevee/output"
 	_ "go.knocknote.io/eevee/plugin"
 	"go.knocknote.io/eevee/repository"
 )
@@ -23,3 +29,139 @@ func TestGenerate(t *testing.T) {
 		t.Fatalf("%+v", err)
 	}
 }
+
+func TestGenerateWithUpsert(t *testing.T) {
+	cfg := &config.Config{
+		ClassPath:  filepath.Join("testdata", "class"),
+		OutputPath: filepath.Join("testdata"),
+		Writer:     output.NewMemoryWriter(),
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := repository.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source, err := cfg.OutputWriter().ReadFile(filepath.Join("testdata", "repository", "user.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		"Upsert(context.Context, *entity.User) (*model.User, error)",
+		"UpsertMulti(context.Context, entity.Users) (*model.Users, error)",
+		"if err := r.userDAO.Upsert(ctx, value); err != nil {",
+		"if err := r.userDAO.UpsertMulti(ctx, entities); err != nil {",
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(source))
+		}
+	}
+	mock, err := cfg.OutputWriter().ReadFile(filepath.Join("testdata", "mock", "repository", "user.go"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, expected := range []string{
+		"func (r *UserMock) Upsert(ctx context.Context, value *entity.User) (r0 *model.User, r1 error) {",
+		"func (r *UserExpect) UpsertMulti(ctx context.Context, entities entity.Users) *UserUpsertMultiExpect {",
+	} {
+		if !strings.Contains(string(mock), expected) {
+			t.Fatalf("cannot find %s in generated source:\n%s", expected, string(mock))
+		}
+	}
+}
+
+func TestGenerateWithFindOption(t *testing.T) {
+	cfg := &config.Config{
+		ClassPath:  filepath.Join("testdata", "class"),
+		OutputPath: filepath.Join("testdata"),
+		Writer:     output.NewMemoryWriter(),
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := dao.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := model.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := repository.NewGenerator(cfg).Generate(classes); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for path, expectedList := range map[string][]string{
+		filepath.Join("testdata", "repository", "user.go"): {
+			"FindAllWithOption(context.Context, *entity.UserFindOption) (*model.Users, error)",
+			"FindByGroupIDWithOption(context.Context, uint64, *entity.UserFindOption) (*model.Users, error)",
+			"values, err := r.userDAO.FindByGroupIDWithOption(a0, a1, a2)",
+		},
+		filepath.Join("testdata", "mock", "repository", "user.go"): {
+			"func (r *UserMock) FindByIDsWithOption(a0 context.Context, a1 []uint64, a2 *entity.UserFindOption) (r0 *model.Users, r1 error) {",
+			"func (r *UserExpect) FindByIDsWithOption(a0 context.Context, a1 []uint64, a2 *entity.UserFindOption) *UserFindByIDsWithOptionExpect {",
+		},
+		filepath.Join("testdata", "model", "user.go"): {
+			"FindByGroupIDWithOption(context.Context, uint64, *entity.UserFindOption) (*Users, error)",
+		},
+	} {
+		source, err := cfg.OutputWriter().ReadFile(path)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, expected := range expectedList {
+			if !strings.Contains(string(source), expected) {
+				t.Fatalf("cannot find %s in generated source:\n%s", expected, string(source))
+			}
+		}
+	}
+}
+
+func TestGenerateWithCustomizedCode(t *testing.T) {
+	writer := output.NewMemoryWriter()
+	cfg := &config.Config{
+		ClassPath:  filepath.Join("testdata", "class"),
+		OutputPath: filepath.Join("testdata"),
+		Writer:     writer,
+	}
+	classes, err := class.NewReader().ClassByConfig(cfg)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	path := filepath.Join("testdata", "repository", "group.go")
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	header := "// Copyright 2020 example. All rights reserved."
+	customizedCodes := []string{
+		"var _ Group = (*GroupImpl)(nil)",
+		"// groupNameCache caches groups found by name\ntype groupNameCache map[string]*model.Group",
+		"// cache of groups is not invalidated by Update",
+		"func (r *GroupImpl) FindByName(ctx context.Context, name string) (*model.Group, error) {\n\treturn nil, nil\n}",
+	}
+	customized := header + "\n\n" + string(content) + "\n" + strings.Join(customizedCodes, "\n\n") + "\n"
+	if err := writer.WriteFile(path, []byte(customized), 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := repository.NewGenerator(cfg).Generate(classes); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	generated, err := writer.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	source := string(generated)
+	if !strings.HasPrefix(source, header+"\n\n// "+code.MergedFileMarker+"\n\npackage repository") {
+		t.Fatalf("expected header comment is kept:\n%s", source)
+	}
+	for _, code := range append(customizedCodes, "type GroupImpl struct {") {
+		if strings.Count(source, code) != 1 {
+			t.Fatalf("expected %s is kept only once:\n%s", code, source)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	. "go.knocknote.io/eevee/code"
@@ -19,7 +20,15 @@ func (r *Generator) Constructor(h *types.RepositoryMethodHelper, constructor *ty
 	properties := Dict{
 		Id(h.DAOName()): Id("dao").Dot(funcName).Call(args[0:]...),
 	}
-	for subClass, constructor := range subClassConstructorMap {
+	subClasses := []*types.Class{}
+	for subClass := range subClassConstructorMap {
+		subClasses = append(subClasses, subClass)
+	}
+	sort.Slice(subClasses, func(i, j int) bool {
+		return subClasses[i].Name.SnakeName() < subClasses[j].Name.SnakeName()
+	})
+	for _, subClass := range subClasses {
+		constructor := subClassConstructorMap[subClass]
 		args := []Code{}
 		for _, arg := range constructor.Args {
 			args = append(args, Id(arg.Name))
@@ -227,8 +236,18 @@ func (r *Generator) expectTimes(h *types.RepositoryMethodHelper, mtd *types.Meth
 }
 
 func (r *Generator) Create(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createBy(h, "Create")
+}
+
+// Upsert creates value or updates existing record that has the same unique key by DAO's Upsert
+func (r *Generator) Upsert(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createBy(h, "Upsert")
+}
+
+// createBy returns method that calls DAO's method ( Create or Upsert ) and converts value to model
+func (r *Generator) createBy(h *types.RepositoryMethodHelper, methodName string) *types.Method {
 	decl := h.CreateMethodDeclare()
-	decl.MethodName = "Create"
+	decl.MethodName = methodName
 	decl.Args = types.ValueDeclares{
 		{
 			Name: "ctx",
@@ -250,8 +269,8 @@ func (r *Generator) Create(h *types.RepositoryMethodHelper) *types.Method {
 	return &types.Method{
 		Decl: decl,
 		Body: []Code{
-			If(Err().Op(":=").Add(h.DAO().Dot("Create").Call(Id("ctx"), Id("value"))), Err().Op("!=").Nil()).Block(
-				Return(Nil(), Qual(h.Package("xerrors"), "Errorf").Call(Lit("cannot Create: %w"), Err())),
+			If(Err().Op(":=").Add(h.DAO().Dot(methodName).Call(Id("ctx"), Id("value"))), Err().Op("!=").Nil()).Block(
+				Return(Nil(), Qual(h.Package("xerrors"), "Errorf").Call(Lit(fmt.Sprintf("cannot %s: %%w", methodName)), Err())),
 			),
 			Id("v").Op(":=").Add(h.Receiver().Dot("ToModel").Call(Id("value"))),
 			Id("v").Dot("SetSavedValue").Call(Id("value")),
@@ -440,8 +459,16 @@ func (r *Generator) toModelMockCode(
 }
 
 func (r *Generator) CreateMock(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createByMock(h, "Create")
+}
+
+func (r *Generator) UpsertMock(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createByMock(h, "Upsert")
+}
+
+func (r *Generator) createByMock(h *types.RepositoryMethodHelper, methodName string) *types.Method {
 	decl := h.CreateMethodDeclare()
-	decl.MethodName = "Create"
+	decl.MethodName = methodName
 	decl.ReceiverClassName = fmt.Sprintf("%sMock", h.Class.Name.CamelName())
 	decl.Args = types.ValueDeclares{
 		{
@@ -465,13 +492,23 @@ func (r *Generator) CreateMock(h *types.RepositoryMethodHelper) *types.Method {
 	}
 	return &types.Method{
 		Decl: decl,
-		Body: r.mockCode(h, "create", decl.Args, decl.Return),
+		Body: r.mockCode(h, types.Name(methodName).CamelLowerName(), decl.Args, decl.Return),
 	}
 }
 
 func (r *Generator) Creates(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createsBy(h, "Creates", "CreateMulti")
+}
+
+// UpsertMulti creates values or updates existing records that have the same unique key by DAO's UpsertMulti
+func (r *Generator) UpsertMulti(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createsBy(h, "UpsertMulti", "UpsertMulti")
+}
+
+// createsBy returns method that calls DAO's method ( CreateMulti or UpsertMulti ) and converts values to models
+func (r *Generator) createsBy(h *types.RepositoryMethodHelper, methodName, daoMethodName string) *types.Method {
 	decl := h.CreateMethodDeclare()
-	decl.MethodName = "Creates"
+	decl.MethodName = methodName
 	decl.Args = types.ValueDeclares{
 		{
 			Name: "ctx",
@@ -494,10 +531,8 @@ func (r *Generator) Creates(h *types.RepositoryMethodHelper) *types.Method {
 	return &types.Method{
 		Decl: decl,
 		Body: []Code{
-			For(List(Id("_"), Id("v")).Op(":=").Range().Id("entities")).Block(
-				If(List(Id("_"), Err()).Op(":=").Add(h.Receiver().Dot("Create").Call(Id("ctx"), Id("v"))), Err().Op("!=").Nil()).Block(
-					Return(Nil(), Qual(h.Package("xerrors"), "Errorf").Call(Lit("cannot Create: %w"), Err())),
-				),
+			If(Err().Op(":=").Add(h.DAO().Dot(daoMethodName).Call(Id("ctx"), Id("entities"))), Err().Op("!=").Nil()).Block(
+				Return(Nil(), Qual(h.Package("xerrors"), "Errorf").Call(Lit(fmt.Sprintf("cannot %s: %%w", daoMethodName)), Err())),
 			),
 			Id("values").Op(":=").Add(h.Receiver().Dot("ToModels").Call(Id("entities"))),
 			Id("values").Dot("Each").Call(
@@ -512,9 +547,17 @@ func (r *Generator) Creates(h *types.RepositoryMethodHelper) *types.Method {
 }
 
 func (r *Generator) CreatesMock(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createsByMock(h, "Creates")
+}
+
+func (r *Generator) UpsertMultiMock(h *types.RepositoryMethodHelper) *types.Method {
+	return r.createsByMock(h, "UpsertMulti")
+}
+
+func (r *Generator) createsByMock(h *types.RepositoryMethodHelper, methodName string) *types.Method {
 	decl := h.CreateMethodDeclare()
 	decl.ReceiverClassName = fmt.Sprintf("%sMock", h.Class.Name.CamelName())
-	decl.MethodName = "Creates"
+	decl.MethodName = methodName
 	decl.Args = types.ValueDeclares{
 		{
 			Name: "ctx",
@@ -537,7 +580,7 @@ func (r *Generator) CreatesMock(h *types.RepositoryMethodHelper) *types.Method {
 	}
 	return &types.Method{
 		Decl: decl,
-		Body: r.mockCode(h, "creates", decl.Args, decl.Return),
+		Body: r.mockCode(h, types.Name(methodName).CamelLowerName(), decl.Args, decl.Return),
 	}
 }
 
@@ -0,0 +1,243 @@
+package schema
+
+import (
+	"go.knocknote.io/eevee/plural"
+	"golang.org/x/xerrors"
+)
+
+// schemaSet keeps the state of tables while applying statements in order.
+// schema files under the directory are applied by file name order, so migration files can be read directly.
+type schemaSet struct {
+	schemata  []*Schema
+	tableMap  map[string]*Schema
+	enumTypes map[string][]string
+}
+
+func newSchemaSet() *schemaSet {
+	return &schemaSet{
+		schemata:  []*Schema{},
+		tableMap:  map[string]*Schema{},
+		enumTypes: map[string][]string{},
+	}
+}
+
+// create add table created by CREATE TABLE. if the same table already exists, it is replaced.
+func (s *schemaSet) create(tableName string, schema *Schema) {
+	if _, exists := s.tableMap[tableName]; exists {
+		s.drop(tableName)
+	}
+	s.schemata = append(s.schemata, schema)
+	s.tableMap[tableName] = schema
+}
+
+func (s *schemaSet) table(tableName string) (*Schema, error) {
+	schema, exists := s.tableMap[tableName]
+	if !exists {
+		return nil, xerrors.Errorf("cannot find table %s", tableName)
+	}
+	return schema, nil
+}
+
+func (s *schemaSet) drop(tableName string) {
+	schema, exists := s.tableMap[tableName]
+	if !exists {
+		return
+	}
+	delete(s.tableMap, tableName)
+	schemata := []*Schema{}
+	for _, v := range s.schemata {
+		if v != schema {
+			schemata = append(schemata, v)
+		}
+	}
+	s.schemata = schemata
+}
+
+func (s *schemaSet) rename(oldName, newName string) error {
+	schema, err := s.table(oldName)
+	if err != nil {
+		return xerrors.Errorf("failed to rename table: %w", err)
+	}
+	delete(s.tableMap, oldName)
+	s.tableMap[newName] = schema
+	schema.Name = plural.Singular(newName)
+	return nil
+}
+
+// dropIndex remove index from any table. it is used for DROP INDEX without table name ( PostgreSQL and SQLite ).
+func (s *schemaSet) dropIndex(indexName string) error {
+	for _, schema := range s.schemata {
+		if schema.dropIndex(indexName) {
+			return nil
+		}
+	}
+	return xerrors.Errorf("cannot find index %s", indexName)
+}
+
+func (s *Schema) columnIndex(name string) int {
+	for idx, column := range s.Columns {
+		if column.Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (s *Schema) column(name string) (*Column, error) {
+	idx := s.columnIndex(name)
+	if idx < 0 {
+		return nil, xerrors.Errorf("cannot find column %s in %s", name, s.Name)
+	}
+	return s.Columns[idx], nil
+}
+
+// insertColumn insert column after the column named by after.
+// if first is true, column is inserted at the beginning. if after is empty, column is appended.
+func (s *Schema) insertColumn(column *Column, first bool, after string) error {
+	idx := len(s.Columns)
+	switch {
+	case first:
+		idx = 0
+	case after != "":
+		afterIdx := s.columnIndex(after)
+		if afterIdx < 0 {
+			return xerrors.Errorf("cannot find column %s in %s", after, s.Name)
+		}
+		idx = afterIdx + 1
+	}
+	columns := append([]*Column{}, s.Columns[:idx]...)
+	columns = append(columns, column)
+	s.Columns = append(columns, s.Columns[idx:]...)
+	return nil
+}
+
+// replaceColumn replace definition of column. name of column may be changed by column.Name
+func (s *Schema) replaceColumn(name string, column *Column) error {
+	idx := s.columnIndex(name)
+	if idx < 0 {
+		return xerrors.Errorf("cannot find column %s in %s", name, s.Name)
+	}
+	s.Columns[idx] = column
+	if name != column.Name {
+		s.renameIndexColumn(name, column.Name)
+	}
+	return nil
+}
+
+func (s *Schema) renameColumn(oldName, newName string) error {
+	column, err := s.column(oldName)
+	if err != nil {
+		return xerrors.Errorf("failed to rename column: %w", err)
+	}
+	column.Name = newName
+	s.renameIndexColumn(oldName, newName)
+	return nil
+}
+
+func (s *Schema) renameIndexColumn(oldName, newName string) {
+	rename := func(columns []string) {
+		for idx, column := range columns {
+			if column == oldName {
+				columns[idx] = newName
+			}
+		}
+	}
+	rename(s.Index.PrimaryKey.Columns)
+	for _, key := range s.Index.UniqueKeys {
+		rename(key.Columns)
+	}
+	for _, key := range s.Index.Keys {
+		rename(key.Columns)
+	}
+	for _, foreignKey := range s.ForeignKeys {
+		rename(foreignKey.Columns)
+	}
+}
+
+// dropColumn remove column and indexes that contain the column
+func (s *Schema) dropColumn(name string) error {
+	idx := s.columnIndex(name)
+	if idx < 0 {
+		return xerrors.Errorf("cannot find column %s in %s", name, s.Name)
+	}
+	s.Columns = append(s.Columns[:idx:idx], s.Columns[idx+1:]...)
+	s.Index.PrimaryKey.Columns = removeColumnName(s.Index.PrimaryKey.Columns, name)
+	uniqueKeys := s.Index.UniqueKeys[:0]
+	for _, key := range s.Index.UniqueKeys {
+		if key.Columns = removeColumnName(key.Columns, name); len(key.Columns) > 0 {
+			uniqueKeys = append(uniqueKeys, key)
+		}
+	}
+	s.Index.UniqueKeys = uniqueKeys
+	keys := s.Index.Keys[:0]
+	for _, key := range s.Index.Keys {
+		if key.Columns = removeColumnName(key.Columns, name); len(key.Columns) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	s.Index.Keys = keys
+	foreignKeys := []*ForeignKey{}
+	for _, foreignKey := range s.ForeignKeys {
+		if len(removeColumnName(foreignKey.Columns, name)) == len(foreignKey.Columns) {
+			foreignKeys = append(foreignKeys, foreignKey)
+		}
+	}
+	s.ForeignKeys = foreignKeys
+	return nil
+}
+
+func removeColumnName(columns []string, name string) []string {
+	removed := []string{}
+	for _, column := range columns {
+		if column != name {
+			removed = append(removed, column)
+		}
+	}
+	return removed
+}
+
+// dropIndex remove unique key or key by name.
+// MySQL names index without name by the first column, so it is also matched.
+func (s *Schema) dropIndex(name string) bool {
+	isMatched := func(indexName string, columns []string) bool {
+		if indexName != "" {
+			return indexName == name
+		}
+		return len(columns) > 0 && columns[0] == name
+	}
+	for idx, key := range s.Index.UniqueKeys {
+		if isMatched(key.Name, key.Columns) {
+			s.Index.UniqueKeys = append(s.Index.UniqueKeys[:idx:idx], s.Index.UniqueKeys[idx+1:]...)
+			return true
+		}
+	}
+	for idx, key := range s.Index.Keys {
+		if isMatched(key.Name, key.Columns) {
+			s.Index.Keys = append(s.Index.Keys[:idx:idx], s.Index.Keys[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Schema) dropForeignKey(name string) bool {
+	for idx, foreignKey := range s.ForeignKeys {
+		if foreignKey.Name == name {
+			s.ForeignKeys = append(s.ForeignKeys[:idx:idx], s.ForeignKeys[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// dropConstraint remove constraint by name ( ALTER TABLE ... DROP CONSTRAINT of PostgreSQL )
+func (s *Schema) dropConstraint(name string) error {
+	if s.dropIndex(name) || s.dropForeignKey(name) {
+		return nil
+	}
+	if name == plural.Plural(s.Name)+"_pkey" {
+		s.Index.PrimaryKey.Columns = nil
+		return nil
+	}
+	return xerrors.Errorf("cannot find constraint %s in %s", name, s.Name)
+}
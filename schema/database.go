@@ -0,0 +1,295 @@
+package schema
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"go.knocknote.io/eevee/types"
+	"golang.org/x/xerrors"
+)
+
+// DriverName returns default name of database/sql driver for the dialect
+func (r *Reader) DriverName() string {
+	switch r.dialect {
+	case types.DialectPostgres:
+		return "postgres"
+	case types.DialectSQLite:
+		return "sqlite3"
+	}
+	return "mysql"
+}
+
+// SchemaFromDSN connects to database by driver and dsn, and reads schema from it.
+// if driverName is empty, default driver name of the dialect is used.
+// driver must be registered by importing driver package ( e.g. github.com/go-sql-driver/mysql ).
+func (r *Reader) SchemaFromDSN(driverName, dsn string) ([]*Schema, error) {
+	if driverName == "" {
+		driverName = r.DriverName()
+	}
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to open database by %s driver: %w", driverName, err)
+	}
+	defer db.Close()
+	schemata, err := r.SchemaFromDatabase(db)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read schema from database: %w", err)
+	}
+	return schemata, nil
+}
+
+// SchemaFromDatabase reads tables, columns, indexes and foreign keys from live database.
+// definitions are converted to DDL and parsed by the same way as schema files,
+// so it returns the same schemata as SchemaFromPath.
+func (r *Reader) SchemaFromDatabase(db *sql.DB) ([]*Schema, error) {
+	var (
+		ddl []string
+		err error
+	)
+	switch r.dialect {
+	case types.DialectPostgres:
+		ddl, err = r.postgreSQLDDL(db)
+	case types.DialectSQLite:
+		ddl, err = r.sqliteDDL(db)
+	default:
+		ddl, err = r.mysqlDDL(db)
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get definition of tables: %w", err)
+	}
+	schemata := newSchemaSet()
+	if err := r.parse(strings.Join(ddl, ";\n"), schemata); err != nil {
+		return nil, xerrors.Errorf("cannot parse definition of tables: %w", err)
+	}
+	return r.finish(schemata), nil
+}
+
+func queryStrings(db *sql.DB, query string, args ...interface{}) ([]string, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to query %s: %w", query, err)
+	}
+	defer rows.Close()
+	values := []string{}
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, xerrors.Errorf("failed to scan: %w", err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read rows: %w", err)
+	}
+	return values, nil
+}
+
+// mysqlDDL returns result of SHOW CREATE TABLE for tables in current database
+func (r *Reader) mysqlDDL(db *sql.DB) ([]string, error) {
+	tableNames, err := queryStrings(db, `
+SELECT TABLE_NAME FROM information_schema.TABLES
+WHERE TABLE_SCHEMA = DATABASE() AND TABLE_TYPE = 'BASE TABLE'
+ORDER BY TABLE_NAME`)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get tables: %w", err)
+	}
+	ddl := []string{}
+	for _, tableName := range tableNames {
+		var name, createTable string
+		query := fmt.Sprintf("SHOW CREATE TABLE `%s`", strings.Replace(tableName, "`", "``", -1))
+		if err := db.QueryRow(query).Scan(&name, &createTable); err != nil {
+			return nil, xerrors.Errorf("failed to get definition of %s: %w", tableName, err)
+		}
+		ddl = append(ddl, createTable)
+	}
+	return ddl, nil
+}
+
+// sqliteDDL returns statements stored in sqlite_master.
+// SQLite rewrites stored statement by ALTER TABLE, so it is the current definition.
+func (r *Reader) sqliteDDL(db *sql.DB) ([]string, error) {
+	ddl, err := queryStrings(db, `
+SELECT sql FROM sqlite_master
+WHERE type IN ('table', 'index') AND sql IS NOT NULL AND name NOT LIKE 'sqlite_%'
+ORDER BY CASE type WHEN 'table' THEN 0 ELSE 1 END, rowid`)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get definition from sqlite_master: %w", err)
+	}
+	return ddl, nil
+}
+
+func quotePostgreSQLIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+func quotePostgreSQLString(value string) string {
+	return "'" + strings.Replace(value, "'", "''", -1) + "'"
+}
+
+// postgreSQLDDL builds CREATE TYPE, CREATE TABLE, CREATE INDEX and COMMENT ON statements
+// from system catalogs of current schema
+func (r *Reader) postgreSQLDDL(db *sql.DB) ([]string, error) {
+	ddl, err := r.postgreSQLEnumTypes(db)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get enum types: %w", err)
+	}
+	rows, err := db.Query(`
+SELECT c.oid, c.relname FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = current_schema() AND c.relkind IN ('r', 'p')
+ORDER BY c.relname`)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get tables: %w", err)
+	}
+	defer rows.Close()
+	type table struct {
+		oid  int64
+		name string
+	}
+	tables := []*table{}
+	for rows.Next() {
+		var t table
+		if err := rows.Scan(&t.oid, &t.name); err != nil {
+			return nil, xerrors.Errorf("failed to scan table: %w", err)
+		}
+		tables = append(tables, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read tables: %w", err)
+	}
+	for _, t := range tables {
+		statements, err := r.postgreSQLTableDDL(db, t.oid, t.name)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get definition of %s: %w", t.name, err)
+		}
+		ddl = append(ddl, statements...)
+	}
+	return ddl, nil
+}
+
+func (r *Reader) postgreSQLEnumTypes(db *sql.DB) ([]string, error) {
+	rows, err := db.Query(`
+SELECT t.typname, e.enumlabel FROM pg_type t
+JOIN pg_enum e ON e.enumtypid = t.oid
+JOIN pg_namespace n ON n.oid = t.typnamespace
+WHERE n.nspname = current_schema()
+ORDER BY t.typname, e.enumsortorder`)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to query enum types: %w", err)
+	}
+	defer rows.Close()
+	typeNames := []string{}
+	valuesMap := map[string][]string{}
+	for rows.Next() {
+		var typeName, value string
+		if err := rows.Scan(&typeName, &value); err != nil {
+			return nil, xerrors.Errorf("failed to scan enum type: %w", err)
+		}
+		if _, exists := valuesMap[typeName]; !exists {
+			typeNames = append(typeNames, typeName)
+		}
+		valuesMap[typeName] = append(valuesMap[typeName], quotePostgreSQLString(value))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read enum types: %w", err)
+	}
+	ddl := []string{}
+	for _, typeName := range typeNames {
+		ddl = append(ddl, fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)",
+			quotePostgreSQLIdentifier(typeName),
+			strings.Join(valuesMap[typeName], ", "),
+		))
+	}
+	return ddl, nil
+}
+
+func (r *Reader) postgreSQLTableDDL(db *sql.DB, oid int64, tableName string) ([]string, error) {
+	rows, err := db.Query(`
+SELECT a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull,
+       COALESCE(pg_get_expr(d.adbin, d.adrelid), ''), a.attidentity <> '',
+       COALESCE(col_description(a.attrelid, a.attnum), '')
+FROM pg_attribute a
+LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE a.attrelid = $1 AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum`, oid)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get columns: %w", err)
+	}
+	defer rows.Close()
+	definitions := []string{}
+	comments := []string{}
+	for rows.Next() {
+		var (
+			name, columnType, defaultValue, comment string
+			notNull, isIdentity                     bool
+		)
+		if err := rows.Scan(&name, &columnType, &notNull, &defaultValue, &isIdentity, &comment); err != nil {
+			return nil, xerrors.Errorf("failed to scan column: %w", err)
+		}
+		definition := fmt.Sprintf("%s %s", quotePostgreSQLIdentifier(name), columnType)
+		if notNull {
+			definition += " NOT NULL"
+		}
+		switch {
+		case isIdentity, strings.HasPrefix(defaultValue, "nextval("):
+			// serial column is expressed as sequence default value in catalog
+			definition += " GENERATED BY DEFAULT AS IDENTITY"
+		case defaultValue != "":
+			definition += " DEFAULT " + defaultValue
+		}
+		definitions = append(definitions, definition)
+		if comment != "" {
+			comments = append(comments, fmt.Sprintf("COMMENT ON COLUMN %s.%s IS %s",
+				quotePostgreSQLIdentifier(tableName),
+				quotePostgreSQLIdentifier(name),
+				quotePostgreSQLString(comment),
+			))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read columns: %w", err)
+	}
+	constraints, err := r.postgreSQLConstraints(db, oid)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get constraints: %w", err)
+	}
+	definitions = append(definitions, constraints...)
+	indexes, err := queryStrings(db, `
+SELECT pg_get_indexdef(i.indexrelid) FROM pg_index i
+WHERE i.indrelid = $1 AND NOT EXISTS (SELECT 1 FROM pg_constraint c WHERE c.conindid = i.indexrelid)
+ORDER BY i.indexrelid`, oid)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to get indexes: %w", err)
+	}
+	ddl := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n  %s\n)", quotePostgreSQLIdentifier(tableName), strings.Join(definitions, ",\n  ")),
+	}
+	ddl = append(ddl, indexes...)
+	ddl = append(ddl, comments...)
+	return ddl, nil
+}
+
+// postgreSQLConstraints returns primary key, unique and foreign key constraints as table constraint definition
+func (r *Reader) postgreSQLConstraints(db *sql.DB, oid int64) ([]string, error) {
+	rows, err := db.Query(`
+SELECT conname, pg_get_constraintdef(oid) FROM pg_constraint
+WHERE conrelid = $1 AND contype IN ('p', 'u', 'f')
+ORDER BY conname`, oid)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to query constraints: %w", err)
+	}
+	defer rows.Close()
+	constraints := []string{}
+	for rows.Next() {
+		var name, definition string
+		if err := rows.Scan(&name, &definition); err != nil {
+			return nil, xerrors.Errorf("failed to scan constraint: %w", err)
+		}
+		constraints = append(constraints, fmt.Sprintf("CONSTRAINT %s %s", quotePostgreSQLIdentifier(name), definition))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read constraints: %w", err)
+	}
+	return constraints, nil
+}
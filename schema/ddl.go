@@ -0,0 +1,928 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"go.knocknote.io/eevee/plural"
+	"go.knocknote.io/eevee/types"
+	"golang.org/x/xerrors"
+)
+
+type ddlTokenKind int
+
+const (
+	ddlTokenWord ddlTokenKind = iota
+	ddlTokenQuotedIdent
+	ddlTokenString
+	ddlTokenSymbol
+)
+
+type ddlToken struct {
+	kind ddlTokenKind
+	text string
+}
+
+func (t *ddlToken) is(keywords ...string) bool {
+	if t == nil || t.kind != ddlTokenWord {
+		return false
+	}
+	for _, keyword := range keywords {
+		if strings.EqualFold(t.text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *ddlToken) isSymbol(symbol string) bool {
+	return t != nil && t.kind == ddlTokenSymbol && t.text == symbol
+}
+
+// ident returns identifier name. unquoted identifier is folded to lower case like PostgreSQL
+func (t *ddlToken) ident() string {
+	if t.kind == ddlTokenQuotedIdent {
+		return t.text
+	}
+	return strings.ToLower(t.text)
+}
+
+func isDDLWordChar(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func tokenizeDDL(sql string) ([]*ddlToken, error) {
+	src := []rune(sql)
+	tokens := []*ddlToken{}
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '-' && i+1 < len(src) && src[i+1] == '-':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			i += 2
+			for i+1 < len(src) && !(src[i] == '*' && src[i+1] == '/') {
+				i++
+			}
+			if i+1 >= len(src) {
+				return nil, xerrors.New("unterminated comment")
+			}
+			i += 2
+		case c == '"' || c == '`' || c == '\'':
+			text := []rune{}
+			i++
+			for {
+				if i >= len(src) {
+					return nil, xerrors.Errorf("unterminated quoted text %s", string(text))
+				}
+				if src[i] == c {
+					if i+1 < len(src) && src[i+1] == c {
+						text = append(text, c)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				text = append(text, src[i])
+				i++
+			}
+			kind := ddlTokenQuotedIdent
+			if c == '\'' {
+				kind = ddlTokenString
+			}
+			tokens = append(tokens, &ddlToken{kind: kind, text: string(text)})
+		case c == ':' && i+1 < len(src) && src[i+1] == ':':
+			tokens = append(tokens, &ddlToken{kind: ddlTokenSymbol, text: "::"})
+			i += 2
+		case isDDLWordChar(c):
+			start := i
+			for i < len(src) && isDDLWordChar(src[i]) {
+				i++
+			}
+			word := string(src[start:i])
+			if (word == "E" || word == "e") && i < len(src) && src[i] == '\'' {
+				// escape string constant ( E'...' )
+				continue
+			}
+			tokens = append(tokens, &ddlToken{kind: ddlTokenWord, text: word})
+		default:
+			tokens = append(tokens, &ddlToken{kind: ddlTokenSymbol, text: string(c)})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+// ddlParser parses CREATE TABLE, ALTER TABLE and CREATE INDEX statements written in standard SQL like PostgreSQL or SQLite
+type ddlParser struct {
+	tokens      []*ddlToken
+	pos         int
+	convertType func(string) string
+	schemata    *schemaSet
+}
+
+func (p *ddlParser) peek() *ddlToken {
+	return p.peekN(0)
+}
+
+func (p *ddlParser) peekN(n int) *ddlToken {
+	if p.pos+n >= len(p.tokens) {
+		return nil
+	}
+	return p.tokens[p.pos+n]
+}
+
+func (p *ddlParser) next() *ddlToken {
+	tok := p.peek()
+	if tok != nil {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *ddlParser) consume(keywords ...string) bool {
+	for idx, keyword := range keywords {
+		if !p.peekN(idx).is(keyword) {
+			return false
+		}
+	}
+	p.pos += len(keywords)
+	return true
+}
+
+func (p *ddlParser) consumeSymbol(symbol string) bool {
+	if p.peek().isSymbol(symbol) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *ddlParser) expectSymbol(symbol string) error {
+	if !p.consumeSymbol(symbol) {
+		return xerrors.Errorf("expected '%s' but got %s", symbol, p.describe())
+	}
+	return nil
+}
+
+func (p *ddlParser) describe() string {
+	tok := p.peek()
+	if tok == nil {
+		return "end of statement"
+	}
+	return tok.text
+}
+
+// skipParens skip tokens enclosed by parentheses ( includes nested parentheses )
+func (p *ddlParser) skipParens() string {
+	if !p.peek().isSymbol("(") {
+		return ""
+	}
+	start := p.pos
+	depth := 0
+	for tok := p.next(); tok != nil; tok = p.next() {
+		switch {
+		case tok.isSymbol("("):
+			depth++
+		case tok.isSymbol(")"):
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	return p.join(start, p.pos)
+}
+
+// skipUntil skip tokens until found ',' or ')' ( in the same depth ) or specified keywords
+func (p *ddlParser) skipUntil(keywords ...string) string {
+	start := p.pos
+	for tok := p.peek(); tok != nil; tok = p.peek() {
+		if tok.isSymbol(",") || tok.isSymbol(")") || tok.isSymbol(";") || tok.is(keywords...) {
+			break
+		}
+		if tok.isSymbol("(") {
+			p.skipParens()
+			continue
+		}
+		p.next()
+	}
+	return p.join(start, p.pos)
+}
+
+func (p *ddlParser) skipStatement() {
+	for tok := p.next(); tok != nil; tok = p.next() {
+		if tok.isSymbol(";") {
+			return
+		}
+	}
+}
+
+func (p *ddlParser) join(start, end int) string {
+	var b strings.Builder
+	for idx, tok := range p.tokens[start:end] {
+		if idx > 0 && tok.kind != ddlTokenSymbol && p.tokens[start+idx-1].kind != ddlTokenSymbol {
+			b.WriteString(" ")
+		}
+		switch tok.kind {
+		case ddlTokenString:
+			b.WriteString("'" + strings.Replace(tok.text, "'", "''", -1) + "'")
+		case ddlTokenQuotedIdent:
+			b.WriteString(`"` + tok.text + `"`)
+		default:
+			b.WriteString(tok.text)
+		}
+	}
+	return b.String()
+}
+
+// parseName parse qualified name like schema.table and returns last part of name
+func (p *ddlParser) parseName() (string, error) {
+	tok := p.next()
+	if tok == nil || (tok.kind != ddlTokenWord && tok.kind != ddlTokenQuotedIdent) {
+		return "", xerrors.Errorf("expected name but got %s", p.describe())
+	}
+	name := tok.ident()
+	for p.consumeSymbol(".") {
+		tok = p.next()
+		if tok == nil {
+			return "", xerrors.New("unexpected end of name")
+		}
+		name = tok.ident()
+	}
+	return name, nil
+}
+
+func (p *ddlParser) parseColumnNames() ([]string, error) {
+	if err := p.expectSymbol("("); err != nil {
+		return nil, xerrors.Errorf("failed to parse column names: %w", err)
+	}
+	names := []string{}
+	for {
+		tok := p.next()
+		if tok == nil {
+			return nil, xerrors.New("unterminated column names")
+		}
+		if tok.kind == ddlTokenWord || tok.kind == ddlTokenQuotedIdent {
+			names = append(names, tok.ident())
+		}
+		// ignore options for column like ASC, DESC, COLLATE or operator class
+		p.skipUntil()
+		if p.consumeSymbol(")") {
+			return names, nil
+		}
+		if err := p.expectSymbol(","); err != nil {
+			return nil, xerrors.Errorf("failed to parse column names: %w", err)
+		}
+	}
+}
+
+var ddlColumnConstraintKeywords = []string{
+	"CONSTRAINT", "NOT", "NULL", "DEFAULT", "PRIMARY", "UNIQUE",
+	"REFERENCES", "CHECK", "GENERATED", "COLLATE",
+}
+
+func (p *ddlParser) parseColumnType() string {
+	var b strings.Builder
+	for tok := p.peek(); tok != nil; tok = p.peek() {
+		if tok.isSymbol(",") || tok.isSymbol(")") || tok.isSymbol(";") || tok.is(ddlColumnConstraintKeywords...) {
+			break
+		}
+		if tok.is("USING") {
+			// ALTER COLUMN ... TYPE type USING expression
+			break
+		}
+		switch {
+		case tok.isSymbol("("):
+			b.WriteString(p.skipParens())
+		case tok.isSymbol("["):
+			p.next()
+			b.WriteString("[")
+			for t := p.next(); t != nil && !t.isSymbol("]"); t = p.next() {
+				b.WriteString(t.text)
+			}
+			b.WriteString("]")
+		default:
+			p.next()
+			if b.Len() > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString(strings.ToLower(tok.ident()))
+		}
+	}
+	return b.String()
+}
+
+func (p *ddlParser) parseColumn(schema *Schema) error {
+	name, err := p.parseName()
+	if err != nil {
+		return xerrors.Errorf("failed to parse column name: %w", err)
+	}
+	columnType := p.parseColumnType()
+	column := &Column{
+		Name:    name,
+		Type:    p.convertType(columnType),
+		SQLType: columnType,
+	}
+	p.applyEnumType(column)
+	nullable := true
+	if isSerialType(columnType) {
+		nullable = false
+		column.AutoIncrement = true
+	}
+	for {
+		switch {
+		case p.consume("CONSTRAINT"):
+			p.next()
+		case p.consume("NOT", "NULL"):
+			nullable = false
+		case p.consume("NULL"):
+			nullable = true
+		case p.consume("DEFAULT"):
+			column.Default = p.parseDefault()
+		case p.consume("PRIMARY", "KEY"):
+			nullable = false
+			schema.Index.PrimaryKey = types.NewPrimaryKey(name)
+		case p.consume("UNIQUE"):
+			schema.Index.UniqueKeys = append(schema.Index.UniqueKeys, &types.UniqueKey{
+				Columns: []string{name},
+			})
+		case p.consume("REFERENCES"):
+			foreignKey, err := p.parseReference([]string{name})
+			if err != nil {
+				return xerrors.Errorf("failed to parse reference of %s: %w", name, err)
+			}
+			schema.ForeignKeys = append(schema.ForeignKeys, foreignKey)
+			// skip ON DELETE, ON UPDATE, MATCH or DEFERRABLE options
+			p.skipUntil(ddlColumnConstraintKeywords...)
+		case p.consume("CHECK"):
+			if values := p.parseCheckIn(name); len(values) > 0 {
+				column.Enum = values
+			}
+		case p.consume("GENERATED"):
+			// GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY [ ( sequence_options ) ]
+			// GENERATED ALWAYS AS ( generation_expr ) STORED
+			// DEFAULT is also a keyword of column constraint, so BY DEFAULT is consumed before skipping
+			p.consume("BY", "DEFAULT")
+			if strings.Contains(strings.ToUpper(p.skipUntil(ddlColumnConstraintKeywords...)), "IDENTITY") {
+				column.AutoIncrement = true
+			}
+			nullable = false
+		case p.consume("AUTOINCREMENT"), p.consume("AUTO_INCREMENT"):
+			column.AutoIncrement = true
+		case p.consume("COLLATE"):
+			p.next()
+		default:
+			if tok := p.peek(); tok == nil || tok.isSymbol(",") || tok.isSymbol(")") || tok.isSymbol(";") {
+				column.Nullable = nullable
+				schema.Columns = append(schema.Columns, column)
+				return nil
+			}
+			// ignore unknown option
+			p.next()
+		}
+	}
+}
+
+// parseDefault parse expression of DEFAULT clause.
+// string literal ( with optional type cast like 'text'::character varying ) is distinguished from other expressions.
+func (p *ddlParser) parseDefault() *DefaultValue {
+	if tok := p.peek(); tok != nil && tok.kind == ddlTokenString {
+		p.next()
+		if p.consumeSymbol("::") {
+			p.parseColumnType()
+		}
+		if next := p.peek(); next == nil || next.isSymbol(",") || next.isSymbol(")") || next.is(ddlColumnConstraintKeywords...) {
+			return &DefaultValue{Literal: tok.text, IsString: true}
+		}
+		p.pos--
+	}
+	literal := p.skipUntil(ddlColumnConstraintKeywords...)
+	if literal == "" {
+		return nil
+	}
+	return &DefaultValue{Literal: literal}
+}
+
+// parseCheckIn parse CHECK ( column IN ( 'value', ... ) ) constraint and returns values.
+// other check constraints are skipped.
+func (p *ddlParser) parseCheckIn(column string) []string {
+	start := p.pos
+	p.skipParens()
+	end := p.pos
+	tokens := p.tokens[start:end]
+	if len(tokens) < 6 {
+		return nil
+	}
+	name := tokens[1]
+	if name.kind != ddlTokenWord && name.kind != ddlTokenQuotedIdent {
+		return nil
+	}
+	if name.ident() != column || !tokens[2].is("IN") || !tokens[3].isSymbol("(") {
+		return nil
+	}
+	values := []string{}
+	for idx := 4; idx < len(tokens)-2; idx++ {
+		tok := tokens[idx]
+		switch {
+		case tok.kind == ddlTokenString:
+			values = append(values, tok.text)
+		case tok.isSymbol(","):
+		default:
+			return nil
+		}
+	}
+	if !tokens[len(tokens)-2].isSymbol(")") {
+		return nil
+	}
+	return values
+}
+
+// parseCreateEnumType parse CREATE TYPE name AS ENUM ( 'value', ... ) statement of PostgreSQL
+func (p *ddlParser) parseCreateEnumType() error {
+	typeName, err := p.parseName()
+	if err != nil {
+		return xerrors.Errorf("failed to parse type name: %w", err)
+	}
+	if !p.consume("AS", "ENUM") {
+		p.skipStatement()
+		return nil
+	}
+	if err := p.expectSymbol("("); err != nil {
+		return xerrors.Errorf("failed to parse enum %s: %w", typeName, err)
+	}
+	values := []string{}
+	for !p.consumeSymbol(")") {
+		tok := p.next()
+		if tok == nil {
+			return xerrors.Errorf("unterminated enum %s", typeName)
+		}
+		if tok.kind == ddlTokenString {
+			values = append(values, tok.text)
+		}
+	}
+	p.skipStatement()
+	p.schemata.enumTypes[typeName] = values
+	return nil
+}
+
+// applyEnumType set values of enum type declared by CREATE TYPE
+func (p *ddlParser) applyEnumType(column *Column) {
+	if values, exists := p.schemata.enumTypes[column.SQLType]; exists {
+		column.Type = "string"
+		column.Enum = values
+	}
+}
+
+// parseCommentOn parse COMMENT ON COLUMN table.column IS 'comment' statement of PostgreSQL
+func (p *ddlParser) parseCommentOn() error {
+	defer p.skipStatement()
+	if !p.consume("COLUMN") {
+		return nil
+	}
+	names := []string{}
+	for {
+		tok := p.next()
+		if tok == nil || (tok.kind != ddlTokenWord && tok.kind != ddlTokenQuotedIdent) {
+			return xerrors.Errorf("expected column name but got %s", p.describe())
+		}
+		names = append(names, tok.ident())
+		if !p.consumeSymbol(".") {
+			break
+		}
+	}
+	if len(names) < 2 {
+		return xerrors.Errorf("table name is required for comment of column %s", names[0])
+	}
+	if !p.consume("IS") {
+		return xerrors.Errorf("expected IS but got %s", p.describe())
+	}
+	tok := p.next()
+	if tok == nil || tok.kind != ddlTokenString {
+		// COMMENT ON COLUMN ... IS NULL removes comment
+		return nil
+	}
+	tableName, columnName := names[len(names)-2], names[len(names)-1]
+	schema, err := p.schemata.table(tableName)
+	if err != nil {
+		return xerrors.Errorf("failed to comment on %s.%s: %w", tableName, columnName, err)
+	}
+	column, err := schema.column(columnName)
+	if err != nil {
+		return xerrors.Errorf("failed to comment on %s.%s: %w", tableName, columnName, err)
+	}
+	column.Comment = tok.text
+	return nil
+}
+
+func (p *ddlParser) parseTableConstraint(schema *Schema) error {
+	name := ""
+	if p.consume("CONSTRAINT") {
+		tok := p.next()
+		if tok == nil || (tok.kind != ddlTokenWord && tok.kind != ddlTokenQuotedIdent) {
+			return xerrors.Errorf("expected constraint name but got %s", p.describe())
+		}
+		name = tok.ident()
+	}
+	switch {
+	case p.consume("PRIMARY", "KEY"):
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return xerrors.Errorf("failed to parse primary key: %w", err)
+		}
+		schema.Index.PrimaryKey = types.NewPrimaryKey(columns...)
+	case p.consume("UNIQUE"):
+		// skip NULLS [ NOT ] DISTINCT
+		for tok := p.peek(); tok != nil && tok.kind == ddlTokenWord; tok = p.peek() {
+			p.next()
+		}
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return xerrors.Errorf("failed to parse unique key: %w", err)
+		}
+		schema.Index.UniqueKeys = append(schema.Index.UniqueKeys, &types.UniqueKey{Name: name, Columns: columns})
+	case p.consume("FOREIGN", "KEY"):
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return xerrors.Errorf("failed to parse foreign key: %w", err)
+		}
+		if !p.consume("REFERENCES") {
+			return xerrors.Errorf("expected REFERENCES but got %s", p.describe())
+		}
+		foreignKey, err := p.parseReference(columns)
+		if err != nil {
+			return xerrors.Errorf("failed to parse reference: %w", err)
+		}
+		foreignKey.Name = name
+		schema.ForeignKeys = append(schema.ForeignKeys, foreignKey)
+	}
+	p.skipUntil()
+	return nil
+}
+
+// parseReference parse the rest of REFERENCES clause ( table [ ( column [, ...] ) ] ).
+// if referenced columns are omitted, they are resolved to primary key of referenced table later.
+func (p *ddlParser) parseReference(columns []string) (*ForeignKey, error) {
+	tableName, err := p.parseName()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse referenced table name: %w", err)
+	}
+	referenceColumns := []string{}
+	if p.peek().isSymbol("(") {
+		referenceColumns, err = p.parseColumnNames()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to parse referenced columns of %s: %w", tableName, err)
+		}
+	}
+	return &ForeignKey{
+		Columns:          columns,
+		ReferenceTable:   tableName,
+		ReferenceColumns: referenceColumns,
+	}, nil
+}
+
+func (p *ddlParser) isTableConstraint() bool {
+	return p.peek().is("CONSTRAINT", "PRIMARY", "UNIQUE", "FOREIGN", "CHECK", "EXCLUDE", "LIKE")
+}
+
+func (p *ddlParser) parseCreateTable() error {
+	p.consume("IF", "NOT", "EXISTS")
+	tableName, err := p.parseName()
+	if err != nil {
+		return xerrors.Errorf("failed to parse table name: %w", err)
+	}
+	schema := &Schema{
+		Name:        plural.Singular(tableName),
+		Index:       &types.INDEX{},
+		Columns:     []*Column{},
+		ForeignKeys: []*ForeignKey{},
+	}
+	if err := p.expectSymbol("("); err != nil {
+		return xerrors.Errorf("failed to parse table %s: %w", tableName, err)
+	}
+	for !p.consumeSymbol(")") {
+		if p.isTableConstraint() {
+			if err := p.parseTableConstraint(schema); err != nil {
+				return xerrors.Errorf("failed to parse constraint of %s: %w", tableName, err)
+			}
+		} else if err := p.parseColumn(schema); err != nil {
+			return xerrors.Errorf("failed to parse column of %s: %w", tableName, err)
+		}
+		if p.consumeSymbol(",") {
+			continue
+		}
+		if !p.peek().isSymbol(")") {
+			return xerrors.Errorf("unexpected token %s in table %s", p.describe(), tableName)
+		}
+	}
+	p.skipStatement()
+	p.schemata.create(tableName, schema)
+	return nil
+}
+
+// parseAlterTable parse ALTER TABLE statement and apply actions to the table
+func (p *ddlParser) parseAlterTable() error {
+	p.consume("IF", "EXISTS")
+	p.consume("ONLY")
+	tableName, err := p.parseName()
+	if err != nil {
+		return xerrors.Errorf("failed to parse table name: %w", err)
+	}
+	for {
+		schema, err := p.schemata.table(tableName)
+		if err != nil {
+			return xerrors.Errorf("failed to alter table: %w", err)
+		}
+		newName, err := p.parseAlterTableAction(tableName, schema)
+		if err != nil {
+			return xerrors.Errorf("failed to alter table %s: %w", tableName, err)
+		}
+		tableName = newName
+		if !p.consumeSymbol(",") {
+			break
+		}
+	}
+	p.skipStatement()
+	return nil
+}
+
+// parseAlterTableAction parse an action of ALTER TABLE and returns table name after the action
+func (p *ddlParser) parseAlterTableAction(tableName string, schema *Schema) (string, error) {
+	switch {
+	case p.consume("ADD"):
+		if p.isTableConstraint() {
+			if err := p.parseTableConstraint(schema); err != nil {
+				return "", xerrors.Errorf("failed to add constraint: %w", err)
+			}
+			return tableName, nil
+		}
+		p.consume("COLUMN")
+		p.consume("IF", "NOT", "EXISTS")
+		if err := p.parseColumn(schema); err != nil {
+			return "", xerrors.Errorf("failed to add column: %w", err)
+		}
+	case p.consume("DROP", "CONSTRAINT"):
+		p.consume("IF", "EXISTS")
+		name, err := p.parseName()
+		if err != nil {
+			return "", xerrors.Errorf("failed to parse constraint name: %w", err)
+		}
+		if err := schema.dropConstraint(name); err != nil {
+			return "", xerrors.Errorf("failed to drop constraint: %w", err)
+		}
+	case p.consume("DROP"):
+		p.consume("COLUMN")
+		p.consume("IF", "EXISTS")
+		name, err := p.parseName()
+		if err != nil {
+			return "", xerrors.Errorf("failed to parse column name: %w", err)
+		}
+		if err := schema.dropColumn(name); err != nil {
+			return "", xerrors.Errorf("failed to drop column: %w", err)
+		}
+	case p.consume("ALTER"):
+		p.consume("COLUMN")
+		name, err := p.parseName()
+		if err != nil {
+			return "", xerrors.Errorf("failed to parse column name: %w", err)
+		}
+		column, err := schema.column(name)
+		if err != nil {
+			return "", xerrors.Errorf("failed to alter column: %w", err)
+		}
+		p.parseAlterColumn(column)
+	case p.consume("RENAME", "TO"):
+		newName, err := p.parseName()
+		if err != nil {
+			return "", xerrors.Errorf("failed to parse table name: %w", err)
+		}
+		if err := p.schemata.rename(tableName, newName); err != nil {
+			return "", xerrors.Errorf("failed to rename table: %w", err)
+		}
+		return newName, nil
+	case p.consume("RENAME", "CONSTRAINT"):
+		// constraint name is not used after renaming
+	case p.consume("RENAME"):
+		p.consume("COLUMN")
+		oldName, err := p.parseName()
+		if err != nil {
+			return "", xerrors.Errorf("failed to parse column name: %w", err)
+		}
+		if !p.consume("TO") {
+			return "", xerrors.Errorf("expected TO but got %s", p.describe())
+		}
+		newName, err := p.parseName()
+		if err != nil {
+			return "", xerrors.Errorf("failed to parse column name: %w", err)
+		}
+		if err := schema.renameColumn(oldName, newName); err != nil {
+			return "", xerrors.Errorf("failed to rename column: %w", err)
+		}
+	}
+	// skip CASCADE, RESTRICT or unsupported actions
+	p.skipUntil()
+	return tableName, nil
+}
+
+// parseAlterColumn parse ALTER COLUMN action of PostgreSQL
+func (p *ddlParser) parseAlterColumn(column *Column) {
+	switch {
+	case p.consume("TYPE"), p.consume("SET", "DATA", "TYPE"):
+		column.SQLType = p.parseColumnType()
+		column.Type = p.convertType(column.SQLType)
+		column.Enum = nil
+		p.applyEnumType(column)
+	case p.consume("SET", "NOT", "NULL"):
+		column.Nullable = false
+	case p.consume("DROP", "NOT", "NULL"):
+		column.Nullable = true
+	case p.consume("SET", "DEFAULT"):
+		column.Default = p.parseDefault()
+	case p.consume("DROP", "DEFAULT"):
+		column.Default = nil
+	case p.consume("ADD", "GENERATED"):
+		if strings.Contains(strings.ToUpper(p.skipUntil()), "IDENTITY") {
+			column.AutoIncrement = true
+			column.Nullable = false
+		}
+	case p.consume("DROP", "IDENTITY"):
+		column.AutoIncrement = false
+	}
+}
+
+// parseDropTable parse DROP TABLE [ IF EXISTS ] name [, ...] statement
+func (p *ddlParser) parseDropTable() error {
+	p.consume("IF", "EXISTS")
+	for {
+		tableName, err := p.parseName()
+		if err != nil {
+			return xerrors.Errorf("failed to parse table name: %w", err)
+		}
+		p.schemata.drop(tableName)
+		if !p.consumeSymbol(",") {
+			break
+		}
+	}
+	p.skipStatement()
+	return nil
+}
+
+// parseDropIndex parse DROP INDEX [ IF EXISTS ] name [, ...] statement
+func (p *ddlParser) parseDropIndex() error {
+	p.consume("CONCURRENTLY")
+	ifExists := p.consume("IF", "EXISTS")
+	for {
+		indexName, err := p.parseName()
+		if err != nil {
+			return xerrors.Errorf("failed to parse index name: %w", err)
+		}
+		if err := p.schemata.dropIndex(indexName); err != nil && !ifExists {
+			return xerrors.Errorf("failed to drop index: %w", err)
+		}
+		if !p.consumeSymbol(",") {
+			break
+		}
+	}
+	p.skipStatement()
+	return nil
+}
+
+// parseCreateIndex parse CREATE [ UNIQUE ] INDEX statement and add index to the table
+func (p *ddlParser) parseCreateIndex(isUnique bool) error {
+	p.consume("CONCURRENTLY")
+	p.consume("IF", "NOT", "EXISTS")
+	indexName := ""
+	if !p.peek().is("ON") {
+		name, err := p.parseName()
+		if err != nil {
+			return xerrors.Errorf("failed to parse index name: %w", err)
+		}
+		indexName = name
+	}
+	if !p.consume("ON") {
+		return xerrors.Errorf("expected ON but got %s", p.describe())
+	}
+	p.consume("ONLY")
+	tableName, err := p.parseName()
+	if err != nil {
+		return xerrors.Errorf("failed to parse table name: %w", err)
+	}
+	if p.consume("USING") {
+		p.next()
+	}
+	columns, err := p.parseColumnNames()
+	if err != nil {
+		return xerrors.Errorf("failed to parse index of %s: %w", tableName, err)
+	}
+	p.skipStatement()
+	schema, err := p.schemata.table(tableName)
+	if err != nil {
+		return xerrors.Errorf("failed to create index: %w", err)
+	}
+	if isUnique {
+		schema.Index.UniqueKeys = append(schema.Index.UniqueKeys, &types.UniqueKey{Name: indexName, Columns: columns})
+	} else {
+		schema.Index.Keys = append(schema.Index.Keys, &types.Key{Name: indexName, Columns: columns})
+	}
+	return nil
+}
+
+func (p *ddlParser) parse() error {
+	for p.peek() != nil {
+		if p.consumeSymbol(";") {
+			continue
+		}
+		if p.consume("COMMENT", "ON") {
+			if err := p.parseCommentOn(); err != nil {
+				return xerrors.Errorf("failed to parse comment: %w", err)
+			}
+			continue
+		}
+		if p.consume("ALTER", "TABLE") {
+			if err := p.parseAlterTable(); err != nil {
+				return xerrors.Errorf("failed to parse alter table: %w", err)
+			}
+			continue
+		}
+		if p.consume("DROP") {
+			switch {
+			case p.consume("TABLE"):
+				if err := p.parseDropTable(); err != nil {
+					return xerrors.Errorf("failed to parse drop table: %w", err)
+				}
+			case p.consume("INDEX"):
+				if err := p.parseDropIndex(); err != nil {
+					return xerrors.Errorf("failed to parse drop index: %w", err)
+				}
+			default:
+				p.skipStatement()
+			}
+			continue
+		}
+		if !p.consume("CREATE") {
+			p.skipStatement()
+			continue
+		}
+		p.consume("OR", "REPLACE")
+		p.consume("GLOBAL")
+		p.consume("LOCAL")
+		if p.peek().is("TEMP", "TEMPORARY", "UNLOGGED") {
+			p.next()
+		}
+		switch {
+		case p.consume("TABLE"):
+			if err := p.parseCreateTable(); err != nil {
+				return xerrors.Errorf("failed to parse create table: %w", err)
+			}
+		case p.consume("UNIQUE", "INDEX"):
+			if err := p.parseCreateIndex(true); err != nil {
+				return xerrors.Errorf("failed to parse create unique index: %w", err)
+			}
+		case p.consume("INDEX"):
+			if err := p.parseCreateIndex(false); err != nil {
+				return xerrors.Errorf("failed to parse create index: %w", err)
+			}
+		case p.consume("TYPE"):
+			if err := p.parseCreateEnumType(); err != nil {
+				return xerrors.Errorf("failed to parse create type: %w", err)
+			}
+		default:
+			p.skipStatement()
+		}
+	}
+	return nil
+}
+
+var typeModifierPattern = regexp.MustCompile(`\([^)]*\)`)
+
+// isSerialType whether type is auto-incremented integer type of PostgreSQL
+func isSerialType(typ string) bool {
+	switch typeModifierPattern.ReplaceAllString(typ, "") {
+	case "smallserial", "serial", "bigserial", "serial2", "serial4", "serial8":
+		return true
+	}
+	return false
+}
+
+// parseDDL apply statements to schemata in order
+func parseDDL(sql string, convertType func(string) string, schemata *schemaSet) error {
+	tokens, err := tokenizeDDL(sql)
+	if err != nil {
+		return xerrors.Errorf("cannot tokenize SQL: %w", err)
+	}
+	parser := &ddlParser{
+		tokens:      tokens,
+		convertType: convertType,
+		schemata:    schemata,
+	}
+	if err := parser.parse(); err != nil {
+		return xerrors.Errorf("cannot parse SQL: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+)
+
+var pgArrayPattern = regexp.MustCompile(`(\s*array)?(\[[0-9]*\])+$|\s+array$`)
+
+// convertPostgreSQLTypeToGOType decides Go type by PostgreSQL column type.
+// unknown type ( e.g. range, geometric and user-defined types ) is converted to []byte because database/sql can scan any value to it.
+func (r *Reader) convertPostgreSQLTypeToGOType(pgType string) string {
+	typ := strings.TrimSpace(typeModifierPattern.ReplaceAllString(pgType, ""))
+	if pgArrayPattern.MatchString(typ) {
+		elemType := strings.TrimSpace(pgArrayPattern.ReplaceAllString(typ, ""))
+		return "[]" + r.convertPostgreSQLTypeToGOType(elemType)
+	}
+	switch typ {
+	case "smallint", "int2", "integer", "int", "int4", "smallserial", "serial2", "serial", "serial4":
+		return "int"
+	case "bigint", "int8", "bigserial", "serial8":
+		return "int64"
+	case "real", "float4":
+		return "float32"
+	case "double precision", "float8", "float", "numeric", "decimal":
+		return "float64"
+	case "boolean", "bool":
+		return "bool"
+	case "text", "varchar", "character varying", "char", "character", "bpchar", "citext",
+		"uuid", "name", "inet", "cidr", "macaddr", "interval", "money",
+		"time", "time without time zone", "time with time zone", "timetz":
+		return "string"
+	case "timestamp", "timestamp without time zone", "timestamp with time zone", "timestamptz", "date":
+		return "time.Time"
+	case "json", "jsonb":
+		return "json.RawMessage"
+	case "bytea":
+		return "[]byte"
+	}
+	return "[]byte"
+}
+
+func (r *Reader) parsePostgreSQL(sql string, schemata *schemaSet) error {
+	return parseDDL(sql, r.convertPostgreSQLTypeToGOType, schemata)
+}
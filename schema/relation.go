@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"strings"
+
+	"go.knocknote.io/eevee/plural"
+	"go.knocknote.io/eevee/types"
+)
+
+// ToClasses converts schemata to classes.
+// If FOREIGN KEY constraint is declared, relation members are added to both of referencing and referenced classes.
+func ToClasses(schemata []*Schema) []*types.Class {
+	classes := []*types.Class{}
+	classMap := map[string]*types.Class{}
+	schemaMap := map[string]*Schema{}
+	for _, schema := range schemata {
+		class := schema.ToClass()
+		classes = append(classes, class)
+		classMap[schema.Name] = class
+		schemaMap[schema.Name] = schema
+	}
+	for _, schema := range schemata {
+		for _, foreignKey := range schema.ForeignKeys {
+			referenceSchema, exists := schemaMap[plural.Singular(foreignKey.ReferenceTable)]
+			if !exists {
+				continue
+			}
+			member, inverseMember := foreignKey.relationMembers(schema, referenceSchema)
+			if member == nil {
+				continue
+			}
+			addRelationMember(classMap[schema.Name], member)
+			addRelationMember(classMap[referenceSchema.Name], inverseMember)
+		}
+	}
+	return classes
+}
+
+func addRelationMember(class *types.Class, member *types.Member) {
+	if class.MemberByName(member.Name.SnakeName()) != nil {
+		// do not override column or already added relation
+		return
+	}
+	class.Members = append(class.Members, member)
+}
+
+// relationMembers returns member to refer referenced class from schema and inverse member.
+// relation of eevee supports single column only, so composite foreign key is ignored.
+//
+// e.g.) FOREIGN KEY (user_id) REFERENCES users(id) in user_fields
+//   - user_field class has 'user' member ( to: user, internal: user_id, external: id )
+//   - user class has 'user_fields' member ( to: user_field, internal: id, external: user_id, has_many: true )
+func (fk *ForeignKey) relationMembers(schema, referenceSchema *Schema) (*types.Member, *types.Member) {
+	if len(fk.Columns) != 1 {
+		return nil, nil
+	}
+	column := fk.Columns[0]
+	referenceColumn := ""
+	if len(fk.ReferenceColumns) == 1 {
+		referenceColumn = fk.ReferenceColumns[0]
+	} else if primaryKey := referenceSchema.Index.PrimaryKey; len(fk.ReferenceColumns) == 0 && len(primaryKey.Columns) == 1 {
+		referenceColumn = primaryKey.Columns[0]
+	}
+	if referenceColumn == "" {
+		return nil, nil
+	}
+	name := strings.TrimSuffix(column, "_id")
+	if name == column {
+		name = referenceSchema.Name
+	}
+	isUnique := schema.isUniqueColumn(column)
+	inverseName := schema.Name
+	if !isUnique {
+		inverseName = plural.Plural(schema.Name)
+	}
+	if name != referenceSchema.Name {
+		// distinguish relations by column name ( e.g. sender_id and receiver_id refer the same table )
+		inverseName = name + "_" + inverseName
+	}
+	member := &types.Member{
+		Name:   types.Name(name),
+		Extend: true,
+		Relation: &types.Relation{
+			To:       types.Name(referenceSchema.Name),
+			Internal: types.Name(column),
+			External: types.Name(referenceColumn),
+		},
+	}
+	inverseMember := &types.Member{
+		Name:    types.Name(inverseName),
+		Extend:  true,
+		HasMany: !isUnique,
+		Relation: &types.Relation{
+			To:       types.Name(schema.Name),
+			Internal: types.Name(referenceColumn),
+			External: types.Name(column),
+		},
+	}
+	return member, inverseMember
+}
+
+func (s *Schema) isUniqueColumn(column string) bool {
+	if s.Index == nil {
+		return false
+	}
+	if primaryKey := s.Index.PrimaryKey; len(primaryKey.Columns) == 1 && primaryKey.Columns[0] == column {
+		return true
+	}
+	for _, uniqueKey := range s.Index.UniqueKeys {
+		if len(uniqueKey.Columns) == 1 && uniqueKey.Columns[0] == column {
+			return true
+		}
+	}
+	return false
+}
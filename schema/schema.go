@@ -1,47 +1,126 @@
 package schema
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/blastrain/vitess-sqlparser/sqlparser"
+	"github.com/blastrain/vitess-sqlparser/tidbparser/ast"
+	"github.com/blastrain/vitess-sqlparser/tidbparser/dependency/mysql"
+	"github.com/blastrain/vitess-sqlparser/tidbparser/parser"
+	"go.knocknote.io/eevee/config"
 	"go.knocknote.io/eevee/plural"
 	"go.knocknote.io/eevee/types"
 	"golang.org/x/xerrors"
 )
 
 type Schema struct {
-	Name    string       `yaml:"name"`
-	Index   *types.INDEX `yaml:"index"`
-	Columns []*Column    `yaml:"members"`
+	Name        string        `yaml:"name"`
+	Index       *types.INDEX  `yaml:"index"`
+	Columns     []*Column     `yaml:"members"`
+	ForeignKeys []*ForeignKey `yaml:"-"`
+}
+
+// ForeignKey FOREIGN KEY constraint. Columns refer ReferenceColumns of ReferenceTable
+type ForeignKey struct {
+	Name             string
+	Columns          []string
+	ReferenceTable   string
+	ReferenceColumns []string
 }
 
 type Column struct {
-	Name     string `yaml:"name"`
-	Type     string `yaml:"type"`
-	Nullable bool   `yaml:"-"`
+	Name          string             `yaml:"name"`
+	Type          string             `yaml:"type"`
+	SQLType       string             `yaml:"-"`
+	Nullable      bool               `yaml:"-"`
+	AutoIncrement bool               `yaml:"-"`
+	Comment       string             `yaml:"-"`
+	Default       *DefaultValue      `yaml:"-"`
+	Enum          []string           `yaml:"-"`
+	mappedType    *types.TypeDeclare `yaml:"-"`
+}
+
+// DefaultValue literal in DEFAULT clause. if IsString is true, Literal is unquoted string literal.
+type DefaultValue struct {
+	Literal  string
+	IsString bool
+}
+
+// ValueByType converts literal to the value of Go type.
+// returns nil if literal cannot be expressed by the type ( e.g. CURRENT_TIMESTAMP ).
+func (v *DefaultValue) ValueByType(goType string) interface{} {
+	switch goType {
+	case "string":
+		if v.IsString {
+			return v.Literal
+		}
+	case "bool":
+		if b, err := strconv.ParseBool(strings.ToLower(v.Literal)); err == nil {
+			return b
+		}
+	case "int", "int8", "int16", "int32", "int64":
+		if i, err := strconv.ParseInt(v.Literal, 10, 64); err == nil {
+			return i
+		}
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		if i, err := strconv.ParseUint(v.Literal, 10, 64); err == nil {
+			return i
+		}
+	case "float32", "float64":
+		if f, err := strconv.ParseFloat(v.Literal, 64); err == nil {
+			return f
+		}
+	}
+	return nil
 }
 
 func (c *Column) ToMember() *types.Member {
 	var decl *types.TypeDeclare
-	if c.Type == "time.Time" {
+	switch {
+	case c.mappedType != nil:
+		decl = &types.TypeDeclare{Type: c.mappedType.Type}
+	case c.Type == "time.Time":
 		decl = types.TypeDeclareWithType(&types.Type{
 			PackageName:  "time",
 			Name:         "Time",
 			ImportPath:   "time",
 			DefaultValue: time.Time{},
 		})
-	} else {
+	case c.Type == "json.RawMessage":
+		decl = types.TypeDeclareWithType(&types.Type{
+			PackageName: "json",
+			Name:        "RawMessage",
+			ImportPath:  "encoding/json",
+		})
+	default:
 		decl = types.TypeDeclareWithName(c.Type)
 	}
-	decl.IsPointer = c.Nullable
-	return &types.Member{
-		Name: types.Name(c.Name),
-		Type: decl,
+	// slice can express NULL by nil
+	decl.IsPointer = c.Nullable && !c.isSliceType()
+	if c.mappedType != nil && c.mappedType.IsPointer {
+		decl.IsPointer = true
+	}
+	member := &types.Member{
+		Name:          types.Name(c.Name),
+		Type:          decl,
+		AutoIncrement: c.AutoIncrement,
+		Description:   c.Comment,
+		Enum:          c.Enum,
 	}
+	if c.Default != nil {
+		member.Default = c.Default.ValueByType(c.Type)
+	}
+	return member
+}
+
+func (c *Column) isSliceType() bool {
+	return strings.HasPrefix(c.Type, "[]") || c.Type == "json.RawMessage"
 }
 
 func (s *Schema) FileName() string {
@@ -61,27 +140,56 @@ func (s *Schema) ToClass() *types.Class {
 }
 
 type Reader struct {
+	dialect         types.Dialect
+	typeMapping     map[string]*types.TypeDeclare
 	unsignedPattern *regexp.Regexp
 	floatPattern    *regexp.Regexp
+	doublePattern   *regexp.Regexp
 	bigintPattern   *regexp.Regexp
+	boolPattern     *regexp.Regexp
 	charPattern     *regexp.Regexp
 	datetimePattern *regexp.Regexp
 	intPattern      *regexp.Regexp
 	enumPattern     *regexp.Regexp
+	setPattern      *regexp.Regexp
 	textPattern     *regexp.Regexp
+	timePattern     *regexp.Regexp
+	jsonPattern     *regexp.Regexp
+	binaryPattern   *regexp.Regexp
 }
 
 func NewReader() *Reader {
+	return NewReaderWithDialect(types.DialectMySQL)
+}
+
+func NewReaderWithDialect(dialect types.Dialect) *Reader {
 	return &Reader{
+		dialect:         dialect,
+		typeMapping:     map[string]*types.TypeDeclare{},
 		unsignedPattern: regexp.MustCompile(`UNSIGNED`),
 		floatPattern:    regexp.MustCompile(`float`),
+		doublePattern:   regexp.MustCompile(`^(double|real|decimal|numeric|dec|fixed)\b`),
 		bigintPattern:   regexp.MustCompile(`bigint`),
+		boolPattern:     regexp.MustCompile(`^(tinyint\(1\)|bool|boolean)( |$)`),
 		charPattern:     regexp.MustCompile(`(var)?char`),
-		datetimePattern: regexp.MustCompile(`datetime`),
-		intPattern:      regexp.MustCompile(`int`),
+		datetimePattern: regexp.MustCompile(`^(datetime|timestamp|date)\b`),
+		intPattern:      regexp.MustCompile(`^(tiny|small|medium|big)?int\b|^integer\b|^year\b`),
 		enumPattern:     regexp.MustCompile(`enum`),
+		setPattern:      regexp.MustCompile(`^set\(`),
 		textPattern:     regexp.MustCompile(`text`),
+		timePattern:     regexp.MustCompile(`^time\b`),
+		jsonPattern:     regexp.MustCompile(`^json\b`),
+		binaryPattern:   regexp.MustCompile(`blob|binary|^bit\b|^(geometry|point|linestring|polygon|multipoint|multilinestring|multipolygon|geometrycollection|geomcollection)\b`),
+	}
+}
+
+// NewReaderWithConfig creates Reader by dialect and type_mapping in configuration file
+func NewReaderWithConfig(cfg *config.Config) *Reader {
+	r := NewReaderWithDialect(cfg.SQLDialect())
+	for key, decl := range cfg.TypeMapping {
+		r.typeMapping[strings.ToLower(key)] = decl
 	}
+	return r
 }
 
 func (r *Reader) isStringType(mysqlType string) bool {
@@ -91,12 +199,31 @@ func (r *Reader) isStringType(mysqlType string) bool {
 	if r.enumPattern.MatchString(mysqlType) {
 		return true
 	}
+	if r.setPattern.MatchString(mysqlType) {
+		return true
+	}
 	if r.textPattern.MatchString(mysqlType) {
 		return true
 	}
+	// TIME type can express the value greater than 24 hours, so it cannot be time.Time
+	if r.timePattern.MatchString(mysqlType) {
+		return true
+	}
 	return false
 }
 
+func (r *Reader) isBoolType(mysqlType string) bool {
+	return r.boolPattern.MatchString(mysqlType)
+}
+
+func (r *Reader) isBinaryType(mysqlType string) bool {
+	return r.binaryPattern.MatchString(mysqlType)
+}
+
+func (r *Reader) isJSONType(mysqlType string) bool {
+	return r.jsonPattern.MatchString(mysqlType)
+}
+
 func (r *Reader) isUint64Type(mysqlType string) bool {
 	if r.unsignedPattern.MatchString(mysqlType) &&
 		r.bigintPattern.MatchString(mysqlType) {
@@ -125,10 +252,28 @@ func (r *Reader) isFloat32Type(mysqlType string) bool {
 	return r.floatPattern.MatchString(mysqlType)
 }
 
+// isFloat64Type whether double or fixed-point type.
+// fixed-point type ( decimal ) is converted to float64 by default. if it requires exact value, use type_mapping.
+func (r *Reader) isFloat64Type(mysqlType string) bool {
+	return r.doublePattern.MatchString(mysqlType)
+}
+
+// convertMySQLTypeToGOType decides Go type by MySQL column type.
+// unknown type is converted to []byte because database/sql can scan any value to it.
 func (r *Reader) convertMySQLTypeToGOType(mysqlType string) string {
 	switch {
+	case r.isBoolType(mysqlType):
+		return "bool"
 	case r.isStringType(mysqlType):
 		return "string"
+	case r.isBinaryType(mysqlType):
+		return "[]byte"
+	case r.isJSONType(mysqlType):
+		return "json.RawMessage"
+	case r.isFloat32Type(mysqlType):
+		return "float32"
+	case r.isFloat64Type(mysqlType):
+		return "float64"
 	case r.isUint64Type(mysqlType):
 		return "uint64"
 	case r.isInt64Type(mysqlType):
@@ -139,112 +284,351 @@ func (r *Reader) convertMySQLTypeToGOType(mysqlType string) string {
 		return "int"
 	case r.isTimeType(mysqlType):
 		return "time.Time"
-	case r.isFloat32Type(mysqlType):
-		return "float32"
 	}
-	return mysqlType
+	return "[]byte"
+}
+
+func (r *Reader) exprToString(expr ast.ExprNode) string {
+	if expr == nil {
+		return ""
+	}
+	var buf strings.Builder
+	expr.Format(&buf)
+	return buf.String()
+}
+
+func (r *Reader) comment(column *ast.ColumnDef) string {
+	for _, opt := range column.Options {
+		if opt.Tp != ast.ColumnOptionComment {
+			continue
+		}
+		if value, ok := opt.Expr.(*ast.ValueExpr); ok {
+			if comment, ok := value.GetValue().(string); ok {
+				return comment
+			}
+		}
+	}
+	return ""
+}
+
+func (r *Reader) defaultValue(column *ast.ColumnDef) *DefaultValue {
+	for _, opt := range column.Options {
+		if opt.Tp != ast.ColumnOptionDefaultValue {
+			continue
+		}
+		if value, ok := opt.Expr.(*ast.ValueExpr); ok {
+			switch v := value.GetValue().(type) {
+			case nil:
+				return nil
+			case string:
+				return &DefaultValue{Literal: v, IsString: true}
+			}
+		}
+		return &DefaultValue{Literal: r.exprToString(opt.Expr)}
+	}
+	return nil
+}
+
+func (r *Reader) enumValues(column *ast.ColumnDef) []string {
+	if column.Tp.Tp != mysql.TypeEnum {
+		return nil
+	}
+	return column.Tp.Elems
+}
+
+func (r *Reader) isAutoIncrementColumn(column *ast.ColumnDef) bool {
+	for _, opt := range column.Options {
+		if opt.Tp == ast.ColumnOptionAutoIncrement {
+			return true
+		}
+	}
+	return false
 }
 
-func (r *Reader) isNullableColumn(column *sqlparser.ColumnDef) bool {
+func (r *Reader) isNullableColumn(column *ast.ColumnDef) bool {
 	for _, opt := range column.Options {
-		switch opt.Type {
-		case sqlparser.ColumnOptionNotNull:
+		switch opt.Tp {
+		case ast.ColumnOptionNotNull:
 			return false
-		case sqlparser.ColumnOptionNull:
+		case ast.ColumnOptionNull:
 			return true
-		case sqlparser.ColumnOptionDefaultValue:
-			return opt.Value == "NULL"
+		case ast.ColumnOptionDefaultValue:
+			return r.exprToString(opt.Expr) == "NULL"
 		}
 	}
 	return false
 }
 
-func (r *Reader) parseSQL(stmt sqlparser.Statement) (*Schema, error) {
-	createTable, ok := stmt.(*sqlparser.CreateTable)
-	if !ok {
-		return nil, xerrors.New("only supported create table")
+func (r *Reader) columnNames(keys []*ast.IndexColName) []string {
+	names := []string{}
+	for _, key := range keys {
+		names = append(names, key.Column.Name.String())
 	}
-	tableName := createTable.NewName.Name.String()
-	columns := []*Column{}
-	columnMap := map[string]*Column{}
-	for _, column := range createTable.Columns {
-		columnName := column.Name
-		columnType := r.convertMySQLTypeToGOType(column.Type)
-		column := &Column{
-			Name:     columnName,
-			Type:     columnType,
-			Nullable: r.isNullableColumn(column),
+	return names
+}
+
+func (r *Reader) toColumn(column *ast.ColumnDef) *Column {
+	return &Column{
+		Name:          column.Name.Name.String(),
+		Type:          r.convertMySQLTypeToGOType(column.Tp.String()),
+		SQLType:       column.Tp.String(),
+		Nullable:      r.isNullableColumn(column),
+		AutoIncrement: r.isAutoIncrementColumn(column),
+		Comment:       r.comment(column),
+		Default:       r.defaultValue(column),
+		Enum:          r.enumValues(column),
+	}
+}
+
+func (r *Reader) addConstraint(schema *Schema, constraint *ast.Constraint) {
+	switch constraint.Tp {
+	case ast.ConstraintPrimaryKey:
+		schema.Index.PrimaryKey = types.NewPrimaryKey(r.columnNames(constraint.Keys)...)
+	case ast.ConstraintUniq, ast.ConstraintUniqKey, ast.ConstraintUniqIndex:
+		schema.Index.UniqueKeys = append(schema.Index.UniqueKeys, &types.UniqueKey{
+			Name:    constraint.Name,
+			Columns: r.columnNames(constraint.Keys),
+		})
+	case ast.ConstraintKey, ast.ConstraintIndex:
+		schema.Index.Keys = append(schema.Index.Keys, &types.Key{
+			Name:    constraint.Name,
+			Columns: r.columnNames(constraint.Keys),
+		})
+	case ast.ConstraintForeignKey:
+		if constraint.Refer == nil {
+			return
 		}
-		columnMap[columnName] = column
-		columns = append(columns, column)
+		schema.ForeignKeys = append(schema.ForeignKeys, &ForeignKey{
+			Name:             constraint.Name,
+			Columns:          r.columnNames(constraint.Keys),
+			ReferenceTable:   constraint.Refer.Table.Name.String(),
+			ReferenceColumns: r.columnNames(constraint.Refer.IndexColNames),
+		})
+	}
+}
+
+func (r *Reader) parseCreateTable(createTable *ast.CreateTableStmt) *Schema {
+	tableName := createTable.Table.Name.String()
+	schema := &Schema{
+		Name:        plural.Singular(tableName),
+		Columns:     []*Column{},
+		Index:       &types.INDEX{},
+		ForeignKeys: []*ForeignKey{},
+	}
+	for _, column := range createTable.Cols {
+		schema.Columns = append(schema.Columns, r.toColumn(column))
 	}
-	index := &types.INDEX{}
 	for _, constraint := range createTable.Constraints {
-		switch constraint.Type {
-		case sqlparser.ConstraintPrimaryKey:
-			index.PrimaryKey = constraint.Keys[0].String()
-		case sqlparser.ConstraintUniq, sqlparser.ConstraintUniqKey, sqlparser.ConstraintUniqIndex:
-			uniqueKey := &types.UniqueKey{
-				Columns: []string{},
+		r.addConstraint(schema, constraint)
+	}
+	return schema
+}
+
+func (r *Reader) alterTable(schemata *schemaSet, alterTable *ast.AlterTableStmt) error {
+	tableName := alterTable.Table.Name.String()
+	schema, err := schemata.table(tableName)
+	if err != nil {
+		return xerrors.Errorf("failed to alter table: %w", err)
+	}
+	for _, spec := range alterTable.Specs {
+		switch spec.Tp {
+		case ast.AlterTableAddColumns:
+			first := spec.Position != nil && spec.Position.Tp == ast.ColumnPositionFirst
+			after := ""
+			if spec.Position != nil && spec.Position.Tp == ast.ColumnPositionAfter {
+				after = spec.Position.RelativeColumn.Name.String()
+			}
+			for _, column := range spec.NewColumns {
+				if err := schema.insertColumn(r.toColumn(column), first, after); err != nil {
+					return xerrors.Errorf("failed to add column to %s: %w", tableName, err)
+				}
+				after = column.Name.Name.String()
+				first = false
+			}
+		case ast.AlterTableAddConstraint:
+			r.addConstraint(schema, spec.Constraint)
+		case ast.AlterTableDropColumn:
+			if err := schema.dropColumn(spec.OldColumnName.Name.String()); err != nil {
+				return xerrors.Errorf("failed to drop column: %w", err)
+			}
+		case ast.AlterTableDropPrimaryKey:
+			schema.Index.PrimaryKey = types.NewPrimaryKey()
+		case ast.AlterTableDropIndex:
+			if !schema.dropIndex(spec.Name) {
+				return xerrors.Errorf("cannot find index %s in %s", spec.Name, tableName)
 			}
-			for _, key := range constraint.Keys {
-				uniqueKey.Columns = append(uniqueKey.Columns, key.String())
+		case ast.AlterTableDropForeignKey:
+			if !schema.dropForeignKey(spec.Name) {
+				return xerrors.Errorf("cannot find foreign key %s in %s", spec.Name, tableName)
 			}
-			index.UniqueKeys = append(index.UniqueKeys, uniqueKey)
-		case sqlparser.ConstraintKey, sqlparser.ConstraintIndex:
-			key := &types.Key{
-				Columns: []string{},
+		case ast.AlterTableModifyColumn:
+			column := spec.NewColumns[0]
+			if err := schema.replaceColumn(column.Name.Name.String(), r.toColumn(column)); err != nil {
+				return xerrors.Errorf("failed to modify column: %w", err)
 			}
-			for _, k := range constraint.Keys {
-				key.Columns = append(key.Columns, k.String())
+		case ast.AlterTableChangeColumn:
+			if err := schema.replaceColumn(spec.OldColumnName.Name.String(), r.toColumn(spec.NewColumns[0])); err != nil {
+				return xerrors.Errorf("failed to change column: %w", err)
 			}
-			index.Keys = append(index.Keys, key)
+		case ast.AlterTableAlterColumn:
+			// ALTER COLUMN ... SET DEFAULT or DROP DEFAULT
+			column, err := schema.column(spec.NewColumns[0].Name.Name.String())
+			if err != nil {
+				return xerrors.Errorf("failed to alter column: %w", err)
+			}
+			column.Default = r.defaultValue(spec.NewColumns[0])
+		case ast.AlterTableRenameTable:
+			if err := schemata.rename(tableName, spec.NewTable.Name.String()); err != nil {
+				return xerrors.Errorf("failed to alter table: %w", err)
+			}
+			tableName = spec.NewTable.Name.String()
 		}
 	}
-	return &Schema{
-		Name:    plural.Singular(tableName),
-		Columns: columns,
-		Index:   index,
-	}, nil
+	return nil
 }
 
-func (r *Reader) readSchema(path string) (*Schema, error) {
-	bytes, err := ioutil.ReadFile(path)
+func (r *Reader) applyStatement(schemata *schemaSet, stmt ast.StmtNode) error {
+	switch stmt := stmt.(type) {
+	case *ast.CreateTableStmt:
+		schemata.create(stmt.Table.Name.String(), r.parseCreateTable(stmt))
+	case *ast.AlterTableStmt:
+		if err := r.alterTable(schemata, stmt); err != nil {
+			return xerrors.Errorf("failed to apply alter table: %w", err)
+		}
+	case *ast.CreateIndexStmt:
+		schema, err := schemata.table(stmt.Table.Name.String())
+		if err != nil {
+			return xerrors.Errorf("failed to create index %s: %w", stmt.IndexName, err)
+		}
+		if stmt.Unique {
+			schema.Index.UniqueKeys = append(schema.Index.UniqueKeys, &types.UniqueKey{
+				Name:    stmt.IndexName,
+				Columns: r.columnNames(stmt.IndexColNames),
+			})
+		} else {
+			schema.Index.Keys = append(schema.Index.Keys, &types.Key{
+				Name:    stmt.IndexName,
+				Columns: r.columnNames(stmt.IndexColNames),
+			})
+		}
+	case *ast.DropIndexStmt:
+		schema, err := schemata.table(stmt.Table.Name.String())
+		if err != nil {
+			return xerrors.Errorf("failed to drop index %s: %w", stmt.IndexName, err)
+		}
+		if !schema.dropIndex(stmt.IndexName) && !stmt.IfExists {
+			return xerrors.Errorf("cannot find index %s in %s", stmt.IndexName, stmt.Table.Name.String())
+		}
+	case *ast.DropTableStmt:
+		for _, table := range stmt.Tables {
+			schemata.drop(table.Name.String())
+		}
+	case *ast.RenameTableStmt:
+		for _, tableToTable := range stmt.TableToTables {
+			if err := schemata.rename(tableToTable.OldTable.Name.String(), tableToTable.NewTable.Name.String()); err != nil {
+				return xerrors.Errorf("failed to rename table: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseMySQL apply CREATE TABLE, ALTER TABLE, CREATE INDEX, DROP INDEX, DROP TABLE and RENAME TABLE statements in order.
+// other statements are ignored.
+func (r *Reader) parseMySQL(sql string, schemata *schemaSet) error {
+	stmts, err := parser.New().Parse(sql, "", "")
 	if err != nil {
-		return nil, xerrors.Errorf("cannot read file %s: %w", path, err)
+		return xerrors.Errorf("failed to parse statements: %w", err)
 	}
-	stmt, err := sqlparser.Parse(string(bytes))
+	for _, stmt := range stmts {
+		if err := r.applyStatement(schemata, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reader) parse(sql string, schemata *schemaSet) error {
+	switch r.dialect {
+	case types.DialectPostgres:
+		return r.parsePostgreSQL(sql, schemata)
+	case types.DialectSQLite:
+		return r.parseSQLite(sql, schemata)
+	}
+	return r.parseMySQL(sql, schemata)
+}
+
+func (r *Reader) readSchema(path string, schemata *schemaSet) error {
+	bytes, err := ioutil.ReadFile(path)
 	if err != nil {
-		return nil, xerrors.Errorf("cannot parse SQL [%s]: %w", string(bytes), err)
+		return xerrors.Errorf("cannot read file %s: %w", path, err)
 	}
-	return r.parseSQL(stmt)
+	if err := r.parse(string(bytes), schemata); err != nil {
+		return xerrors.Errorf("cannot parse SQL [%s]: %w", string(bytes), err)
+	}
+	return nil
 }
 
-func (r *Reader) SchemaFromPath(path string) ([]*Schema, error) {
-	if filepath.Ext(path) == "sql" {
-		schema, err := r.readSchema(path)
-		if err != nil {
-			return nil, xerrors.Errorf("cannot read schema file %s: %w", path, err)
-		}
-		return []*Schema{schema}, nil
+// typeMappingKeys returns keys for type_mapping in order of priority.
+// e.g.) if score column of users table is declared as decimal(10,2) UNSIGNED,
+// returns users.score, decimal(10,2) unsigned, decimal unsigned and decimal
+func (r *Reader) typeMappingKeys(schema *Schema, column *Column) []string {
+	sqlType := strings.ToLower(column.SQLType)
+	typeWithoutModifier := strings.Join(strings.Fields(typeModifierPattern.ReplaceAllString(sqlType, " ")), " ")
+	keys := []string{
+		fmt.Sprintf("%s.%s", plural.Plural(schema.Name), column.Name),
+		fmt.Sprintf("%s.%s", schema.Name, column.Name),
+		sqlType,
+		typeWithoutModifier,
 	}
+	if fields := strings.Fields(typeWithoutModifier); len(fields) > 0 {
+		keys = append(keys, fields[0])
+	}
+	return keys
+}
 
-	schemata := []*Schema{}
+func (r *Reader) applyTypeMapping(schema *Schema) {
+	if len(r.typeMapping) == 0 {
+		return
+	}
+	for _, column := range schema.Columns {
+		for _, key := range r.typeMappingKeys(schema, column) {
+			decl, exists := r.typeMapping[key]
+			if !exists {
+				continue
+			}
+			column.Type = decl.Type.Name
+			if decl.Type.PackageName != "" || decl.Type.ImportPath != "" || decl.IsPointer {
+				column.mappedType = decl
+			}
+			break
+		}
+	}
+}
 
+// SchemaFromPath reads schema files and returns the final state of tables.
+// if path is directory, schema files are applied in order of file name.
+func (r *Reader) SchemaFromPath(path string) ([]*Schema, error) {
+	schemata := newSchemaSet()
 	sqlFilePattern := regexp.MustCompile(`\.sql$`)
 	if err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
 		if !sqlFilePattern.MatchString(path) {
 			return nil
 		}
-		schema, err := r.readSchema(path)
-		if err != nil {
+		if err := r.readSchema(path, schemata); err != nil {
 			return xerrors.Errorf("cannot read schema: %w", err)
 		}
-		schemata = append(schemata, schema)
 		return nil
 	}); err != nil {
 		return nil, xerrors.Errorf("interrupt walk in %s: %w", path, err)
 	}
+	return r.finish(schemata), nil
+}
 
-	return schemata, nil
+// finish returns the final state of tables with type_mapping applied
+func (r *Reader) finish(schemata *schemaSet) []*Schema {
+	for _, schema := range schemata.schemata {
+		r.applyTypeMapping(schema)
+	}
+	return schemata.schemata
 }
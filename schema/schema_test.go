@@ -1,13 +1,550 @@
 package schema
 
 import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"go.knocknote.io/eevee/config"
+	"go.knocknote.io/eevee/types"
 )
 
 func TestSchema(t *testing.T) {
 	reader := NewReader()
-	_, err := reader.SchemaFromPath("testdata/schema")
+	schemata, err := reader.SchemaFromPath("testdata/schema")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, schema := range schemata {
+		class := schema.ToClass()
+		autoIncrement := class.MemberByName("id").AutoIncrement
+		if schema.Name == "user" && !autoIncrement {
+			t.Fatal("failed to get AUTO_INCREMENT column")
+		}
+		if schema.Name != "user" && autoIncrement {
+			t.Fatalf("unexpected AUTO_INCREMENT column of %s", schema.Name)
+		}
+	}
+}
+
+func TestPostgreSQLSchema(t *testing.T) {
+	reader := NewReaderWithDialect(types.DialectPostgres)
+	schemata, err := reader.SchemaFromPath("testdata/postgres")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(schemata) != 2 {
+		t.Fatalf("failed to read schemata: %d", len(schemata))
+	}
+	schemaMap := map[string]*Schema{}
+	for _, schema := range schemata {
+		schemaMap[schema.Name] = schema
+	}
+	user, exists := schemaMap["user"]
+	if !exists {
+		t.Fatal("cannot find user schema")
+	}
+	expectedTypes := map[string]string{
+		"id":         "int64",
+		"name":       "string",
+		"age":        "int",
+		"score":      "float64",
+		"tags":       "[]string",
+		"profile":    "json.RawMessage",
+		"icon":       "[]byte",
+		"group_id":   "int64",
+		"created_at": "time.Time",
+	}
+	for _, column := range user.Columns {
+		if expectedTypes[column.Name] != column.Type {
+			t.Fatalf("unexpected type of %s: %s", column.Name, column.Type)
+		}
+	}
+	if columns := user.Index.PrimaryKey.Columns; len(columns) != 1 || columns[0] != "id" {
+		t.Fatalf("failed to get primary key: %v", columns)
+	}
+	if !user.Columns[0].AutoIncrement || user.Columns[7].AutoIncrement {
+		t.Fatal("failed to get serial column")
+	}
+	if len(user.Index.UniqueKeys) != 1 || user.Index.UniqueKeys[0].Columns[0] != "name" {
+		t.Fatal("failed to get unique key")
+	}
+	if len(user.Index.Keys) != 1 || user.Index.Keys[0].Columns[0] != "group_id" {
+		t.Fatal("failed to get key")
+	}
+	group, exists := schemaMap["group"]
+	if !exists {
+		t.Fatal("cannot find group schema")
+	}
+	if columns := group.Index.PrimaryKey.Columns; len(columns) != 1 || columns[0] != "id" {
+		t.Fatalf("failed to get primary key: %v", columns)
+	}
+	if !group.Columns[0].AutoIncrement {
+		t.Fatal("failed to get identity column")
+	}
+	if len(group.Columns) != 3 || group.Columns[0].Nullable || !group.Columns[2].Nullable {
+		t.Fatal("failed to get nullable columns")
+	}
+}
+
+func TestSQLiteSchema(t *testing.T) {
+	reader := NewReaderWithDialect(types.DialectSQLite)
+	schemata, err := reader.SchemaFromPath("testdata/sqlite")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(schemata) != 2 {
+		t.Fatalf("failed to read schemata: %d", len(schemata))
+	}
+	schemaMap := map[string]*Schema{}
+	for _, schema := range schemata {
+		schemaMap[schema.Name] = schema
+	}
+	user, exists := schemaMap["user"]
+	if !exists {
+		t.Fatal("cannot find user schema")
+	}
+	expectedTypes := map[string]string{
+		"id":         "int",
+		"name":       "string",
+		"admin":      "bool",
+		"score":      "float64",
+		"icon":       "[]byte",
+		"memo":       "string",
+		"group_id":   "int",
+		"created_at": "time.Time",
+	}
+	for _, column := range user.Columns {
+		if expectedTypes[column.Name] != column.Type {
+			t.Fatalf("unexpected type of %s: %s", column.Name, column.Type)
+		}
+	}
+	if columns := user.Index.PrimaryKey.Columns; len(columns) != 1 || columns[0] != "id" {
+		t.Fatalf("failed to get primary key: %v", columns)
+	}
+	if len(user.Index.UniqueKeys) != 1 || user.Index.UniqueKeys[0].Columns[0] != "name" {
+		t.Fatal("failed to get unique key")
+	}
+	if len(user.Index.Keys) != 1 || user.Index.Keys[0].Columns[0] != "group_id" {
+		t.Fatal("failed to get key")
+	}
+	if len(user.ForeignKeys) != 1 || user.ForeignKeys[0].ReferenceTable != "groups" {
+		t.Fatal("failed to get foreign key")
+	}
+	if !user.Columns[0].AutoIncrement || user.Columns[6].AutoIncrement {
+		t.Fatal("failed to get AUTOINCREMENT column")
+	}
+	group, exists := schemaMap["group"]
+	if !exists {
+		t.Fatal("cannot find group schema")
+	}
+	if !group.Columns[0].AutoIncrement {
+		t.Fatal("INTEGER PRIMARY KEY column should be alias of rowid")
+	}
+	if group.Columns[2].Type != "int64" || !group.Columns[2].Nullable {
+		t.Fatal("failed to get nullable column")
+	}
+}
+
+func TestForeignKey(t *testing.T) {
+	schemata, err := NewReader().SchemaFromPath("testdata/foreign_key")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	classMap := map[string]*types.Class{}
+	for _, class := range ToClasses(schemata) {
+		classMap[class.Name.SnakeName()] = class
+	}
+	for _, test := range []struct {
+		class    string
+		member   string
+		to       string
+		internal string
+		external string
+		hasMany  bool
+	}{
+		{"message", "user", "user", "user_id", "id", false},
+		{"message", "receiver", "user", "receiver_id", "id", false},
+		{"user_profile", "user", "user", "user_id", "id", false},
+		{"user", "messages", "message", "id", "user_id", true},
+		{"user", "receiver_messages", "message", "id", "receiver_id", true},
+		{"user", "user_profile", "user_profile", "id", "user_id", false},
+	} {
+		class, exists := classMap[test.class]
+		if !exists {
+			t.Fatalf("cannot find %s class", test.class)
+		}
+		member := class.MemberByName(test.member)
+		if member == nil {
+			t.Fatalf("cannot find %s member in %s class", test.member, test.class)
+		}
+		if !member.Extend || member.HasMany != test.hasMany || member.Relation == nil {
+			t.Fatalf("invalid relation member %s.%s", test.class, test.member)
+		}
+		relation := member.Relation
+		if relation.To.SnakeName() != test.to ||
+			relation.Internal.SnakeName() != test.internal ||
+			relation.External.SnakeName() != test.external {
+			t.Fatalf("invalid relation %s.%s: %+v", test.class, test.member, relation)
+		}
+	}
+}
+
+func TestMySQLTypes(t *testing.T) {
+	schemata, err := NewReader().SchemaFromPath("testdata/types")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expectedTypes := map[string]string{
+		"id":         "uint64",
+		"flag":       "bool",
+		"small":      "int",
+		"count":      "uint32",
+		"amount":     "int64",
+		"rate":       "float32",
+		"score":      "float64",
+		"price":      "float64",
+		"born_on":    "time.Time",
+		"played_at":  "time.Time",
+		"updated_at": "time.Time",
+		"duration":   "string",
+		"year":       "int",
+		"name":       "string",
+		"body":       "string",
+		"kind":       "string",
+		"tags":       "string",
+		"profile":    "json.RawMessage",
+		"icon":       "[]byte",
+		"hash":       "[]byte",
+		"bits":       "[]byte",
+		"memo":       "string",
+	}
+	for _, column := range schemata[0].Columns {
+		if expectedTypes[column.Name] != column.Type {
+			t.Fatalf("unexpected type of %s: %s", column.Name, column.Type)
+		}
+	}
+	class := schemata[0].ToClass()
+	kind := class.MemberByName("kind")
+	if kind.Description != "kind of sample" {
+		t.Fatalf("failed to get comment: %s", kind.Description)
+	}
+	if kind.Default != "b" {
+		t.Fatalf("failed to get default value: %v", kind.Default)
+	}
+	if len(kind.Enum) != 2 || kind.Enum[0] != "a" || kind.Enum[1] != "b" {
+		t.Fatalf("failed to get enum values: %v", kind.Enum)
+	}
+	expectedDefaults := map[string]interface{}{
+		"flag":       true,
+		"amount":     int64(100),
+		"rate":       float64(-1.5),
+		"name":       "guest",
+		"updated_at": nil,
+		"profile":    nil,
+	}
+	for name, expected := range expectedDefaults {
+		if value := class.MemberByName(name).Default; value != expected {
+			t.Fatalf("unexpected default value of %s: %v", name, value)
+		}
+	}
+}
+
+func TestPostgreSQLColumnOptions(t *testing.T) {
+	reader := NewReaderWithDialect(types.DialectPostgres)
+	schemata, err := reader.SchemaFromPath("testdata/postgres_options")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	class := schemata[0].ToClass()
+	name := class.MemberByName("name")
+	if name.Description != "display name" || name.Default != "guest" {
+		t.Fatalf("failed to get column options of name: %+v", name)
+	}
+	status := class.MemberByName("status")
+	if status.Type.Name() != "string" || status.Description != "status of account" || status.Default != "active" {
+		t.Fatalf("failed to get column options of status: %+v", status)
+	}
+	if len(status.Enum) != 2 || status.Enum[1] != "banned" {
+		t.Fatalf("failed to get enum values from type: %v", status.Enum)
+	}
+	role := class.MemberByName("role")
+	if len(role.Enum) != 2 || role.Enum[0] != "admin" || role.Default != nil {
+		t.Fatalf("failed to get enum values from check constraint: %+v", role)
+	}
+	level := class.MemberByName("level")
+	if len(level.Enum) != 0 || level.Default != int64(1) {
+		t.Fatalf("failed to get column options of level: %+v", level)
+	}
+	if class.MemberByName("is_public").Default != true {
+		t.Fatal("failed to get default value of boolean")
+	}
+	if class.MemberByName("created_at").Default != nil {
+		t.Fatal("default value of function call should be ignored")
+	}
+}
+
+func TestTypeMapping(t *testing.T) {
+	cfg, err := config.ConfigFromBytes([]byte(`
+type_mapping:
+  decimal:
+    import: github.com/shopspring/decimal
+    package_name: decimal
+    name: Decimal
+  tinyint(1): int8
+  samples.score: float32
+`))
 	if err != nil {
 		t.Fatalf("%+v", err)
 	}
+	schemata, err := NewReaderWithConfig(cfg).SchemaFromPath("testdata/types")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	class := schemata[0].ToClass()
+	price := class.MemberByName("price")
+	if price.Type.Type.ImportPath != "github.com/shopspring/decimal" || price.Type.Name() != "Decimal" {
+		t.Fatalf("failed to map decimal type: %+v", price.Type.Type)
+	}
+	if class.MemberByName("flag").Type.Name() != "int8" {
+		t.Fatal("failed to map tinyint(1) type")
+	}
+	if class.MemberByName("score").Type.Name() != "float32" {
+		t.Fatal("failed to map type by column name")
+	}
+	if class.MemberByName("memo").Type.Name() != "string" || !class.MemberByName("memo").Type.IsPointer {
+		t.Fatal("unexpected type of column without mapping")
+	}
+}
+
+func TestMigration(t *testing.T) {
+	schemata, err := NewReader().SchemaFromPath("testdata/migration")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(schemata) != 2 || schemata[0].Name != "user" || schemata[1].Name != "team" {
+		t.Fatalf("failed to apply create, drop and rename table: %+v", schemata)
+	}
+	user := schemata[0]
+	columnNames := []string{}
+	for _, column := range user.Columns {
+		columnNames = append(columnNames, column.Name)
+	}
+	if strings.Join(columnNames, ",") != "id,group_id,name,created_at" {
+		t.Fatalf("failed to apply alter table: %v", columnNames)
+	}
+	if name := user.Columns[2]; !name.Nullable || name.SQLType != "varchar(64)" {
+		t.Fatalf("failed to modify column: %+v", name)
+	}
+	if createdAt := user.Columns[3]; createdAt.Type != "time.Time" || createdAt.Nullable {
+		t.Fatalf("failed to change column: %+v", createdAt)
+	}
+	if len(user.Index.Keys) != 1 || user.Index.Keys[0].Columns[0] != "group_id" {
+		t.Fatalf("failed to add and drop index: %+v", user.Index.Keys)
+	}
+	if len(user.Index.UniqueKeys) != 1 || user.Index.UniqueKeys[0].Columns[0] != "name" {
+		t.Fatalf("failed to create index: %+v", user.Index.UniqueKeys)
+	}
+}
+
+func TestPostgreSQLMigration(t *testing.T) {
+	reader := NewReaderWithDialect(types.DialectPostgres)
+	schemata, err := reader.SchemaFromPath("testdata/postgres_migration")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(schemata) != 1 || schemata[0].Name != "account" {
+		t.Fatalf("failed to rename table: %+v", schemata)
+	}
+	account := schemata[0]
+	if len(account.Columns) != 3 {
+		t.Fatalf("failed to apply alter table: %+v", account.Columns)
+	}
+	if id := account.Columns[0]; !id.AutoIncrement || id.Nullable || id.Default != nil {
+		t.Fatalf("failed to parse identity column: %+v", id)
+	}
+	displayName := account.Columns[1]
+	if displayName.Name != "display_name" || !displayName.Nullable || displayName.SQLType != "text" {
+		t.Fatalf("failed to alter column: %+v", displayName)
+	}
+	status := account.Columns[2]
+	if status.Name != "status" || len(status.Enum) != 2 || status.Default.Literal != "active" || status.Comment != "status of account" {
+		t.Fatalf("failed to add column: %+v", status)
+	}
+	if len(account.Index.Keys) != 0 {
+		t.Fatalf("failed to drop index: %+v", account.Index.Keys)
+	}
+	if len(account.Index.UniqueKeys) != 1 || account.Index.UniqueKeys[0].Columns[0] != "display_name" {
+		t.Fatalf("failed to add constraint: %+v", account.Index.UniqueKeys)
+	}
+}
+
+func TestSQLiteSchemaFromDatabase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eevee")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(dir)
+	dsn := filepath.Join(dir, "test.db")
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer db.Close()
+	for _, path := range []string{"testdata/sqlite/groups.sql", "testdata/sqlite/users.sql"} {
+		ddl, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := db.Exec(string(ddl)); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+	reader := NewReaderWithDialect(types.DialectSQLite)
+	expected, err := reader.SchemaFromPath("testdata/sqlite")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	schemata, err := reader.SchemaFromDSN("", dsn)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !reflect.DeepEqual(expected, schemata) {
+		t.Fatalf("schemata from database are different from schema files: %+v", schemata)
+	}
+	if _, err := db.Exec("ALTER TABLE `users` ADD COLUMN `nickname` TEXT"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	schemata, err = reader.SchemaFromDSN("", dsn)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, schema := range schemata {
+		if schema.Name != "user" {
+			continue
+		}
+		column := schema.Columns[len(schema.Columns)-1]
+		if column.Name != "nickname" || column.Type != "string" || !column.Nullable {
+			t.Fatalf("failed to get added column: %+v", column)
+		}
+		return
+	}
+	t.Fatal("cannot find user schema")
+}
+
+func TestInvalidDDL(t *testing.T) {
+	for _, dialect := range []types.Dialect{types.DialectPostgres, types.DialectSQLite} {
+		for _, sql := range []string{
+			"CREATE TABLE t (a int, CONSTRAINT",
+			"CREATE TABLE t (a int, CONSTRAINT (",
+		} {
+			reader := NewReaderWithDialect(dialect)
+			if err := reader.parse(sql, newSchemaSet()); err == nil {
+				t.Fatalf("expected error for %s in %s", sql, dialect)
+			}
+		}
+	}
+}
+
+func TestMySQLTypeNames(t *testing.T) {
+	reader := NewReader()
+	for typeName, expected := range map[string]string{
+		"int(11)":                   "int",
+		"mediumint(9)":              "int",
+		"integer":                   "int",
+		"year":                      "int",
+		"point":                     "[]byte",
+		"linestring":                "[]byte",
+		"polygon":                   "[]byte",
+		"multipoint":                "[]byte",
+		"multilinestring":           "[]byte",
+		"multipolygon":              "[]byte",
+		"geometry":                  "[]byte",
+		"geometrycollection":        "[]byte",
+		"geomcollection":            "[]byte",
+		"int(10) UNSIGNED":          "uint32",
+		"bigint(20) UNSIGNED":       "uint64",
+		"varchar(255)":              "string",
+		"tinyint(1)":                "bool",
+		"decimal(10,2)":             "float64",
+		"datetime":                  "time.Time",
+		"unknown_interval_of_point": "[]byte",
+	} {
+		if typ := reader.convertMySQLTypeToGOType(typeName); typ != expected {
+			t.Fatalf("unexpected type of %s: %s", typeName, typ)
+		}
+	}
+}
+
+func TestPostgreSQLTypeNames(t *testing.T) {
+	reader := NewReaderWithDialect(types.DialectPostgres)
+	for typeName, expected := range map[string]string{
+		"integer":           "int",
+		"varchar(255)":      "string",
+		"timestamptz":       "time.Time",
+		"text[]":            "[]string",
+		"int4range":         "[]byte",
+		"point":             "[]byte",
+		"tsvector":          "[]byte",
+		"user-defined":      "[]byte",
+		"int4range[]":       "[][]byte",
+		"numeric(10,2)":     "float64",
+		"character varying": "string",
+	} {
+		if typ := reader.convertPostgreSQLTypeToGOType(typeName); typ != expected {
+			t.Fatalf("unexpected type of %s: %s", typeName, typ)
+		}
+	}
+	cfg, err := config.ConfigFromBytes([]byte(`
+dialect: postgres
+type_mapping:
+  tsvector: string
+`))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	schemata := newSchemaSet()
+	reader = NewReaderWithConfig(cfg)
+	if err := reader.parse(`CREATE TABLE "documents" ("id" bigserial NOT NULL, "body" tsvector, "period" int4range, PRIMARY KEY ("id"));`, schemata); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	class := reader.finish(schemata)[0].ToClass()
+	if class.MemberByName("body").Type.Name() != "string" {
+		t.Fatal("failed to map unknown type")
+	}
+	if class.MemberByName("period").Type.Name() != "[]byte" {
+		t.Fatal("unexpected type of unknown type without mapping")
+	}
+}
+
+func TestSQLiteTypeNames(t *testing.T) {
+	reader := NewReaderWithDialect(types.DialectSQLite)
+	for typeName, expected := range map[string]string{
+		"integer":           "int",
+		"mediumint":         "int",
+		"varchar(255)":      "string",
+		"nvarchar(100)":     "string",
+		"clob":              "string",
+		"blob":              "[]byte",
+		"":                  "[]byte",
+		"double precision":  "float64",
+		"real":              "float64",
+		"decimal(10,5)":     "float64",
+		"numeric":           "float64",
+		"datetime":          "time.Time",
+		"datetime2":         "float64",
+		"custom_affinity":   "float64",
+		"unsigned big int":  "uint64",
+		"character(20)":     "string",
+		"varying character": "string",
+	} {
+		if typ := reader.convertSQLiteTypeToGOType(typeName); typ != expected {
+			t.Fatalf("unexpected type of %s: %s", typeName, typ)
+		}
+	}
 }
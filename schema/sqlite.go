@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"strings"
+)
+
+// convertSQLiteTypeToGOType decides Go type by declared type name.
+// SQLite accepts any type name, so this follows the rules of type affinity ( https://www.sqlite.org/datatype3.html )
+// after checking the well-known type names.
+func (r *Reader) convertSQLiteTypeToGOType(sqliteType string) string {
+	typ := strings.TrimSpace(typeModifierPattern.ReplaceAllString(sqliteType, ""))
+	switch typ {
+	case "boolean", "bool":
+		return "bool"
+	case "bigint", "int8":
+		return "int64"
+	case "unsigned big int":
+		return "uint64"
+	case "datetime", "timestamp", "date":
+		return "time.Time"
+	case "json":
+		return "json.RawMessage"
+	case "float":
+		return "float32"
+	case "":
+		return "[]byte"
+	}
+	switch {
+	case strings.Contains(typ, "int"):
+		return "int"
+	case strings.Contains(typ, "char"), strings.Contains(typ, "clob"), strings.Contains(typ, "text"):
+		return "string"
+	case strings.Contains(typ, "blob"):
+		return "[]byte"
+	case strings.Contains(typ, "real"), strings.Contains(typ, "floa"), strings.Contains(typ, "doub"):
+		return "float64"
+	}
+	// other type names ( e.g. numeric, decimal, datetime2 ) have NUMERIC affinity
+	return "float64"
+}
+
+func (r *Reader) parseSQLite(sql string, schemata *schemaSet) error {
+	if err := parseDDL(sql, r.convertSQLiteTypeToGOType, schemata); err != nil {
+		return err
+	}
+	for _, schema := range schemata.schemata {
+		markRowIDAlias(schema)
+	}
+	return nil
+}
+
+// markRowIDAlias marks INTEGER PRIMARY KEY column as auto increment column.
+// the column becomes an alias of rowid, so SQLite assigns the value when it is not specified.
+func markRowIDAlias(schema *Schema) {
+	if len(schema.Index.PrimaryKey.Columns) != 1 {
+		return
+	}
+	for _, column := range schema.Columns {
+		if column.Name != schema.Index.PrimaryKey.Columns[0] {
+			continue
+		}
+		if strings.TrimSpace(column.SQLType) == "integer" {
+			column.AutoIncrement = true
+		}
+	}
+}
@@ -0,0 +1,8 @@
+CREATE TABLE `user_profiles` (
+  `id` bigint(20) unsigned NOT NULL,
+  `user_id` bigint(20) unsigned NOT NULL,
+  `bio` text NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uq_user_profiles_01` (`user_id`),
+  CONSTRAINT `fk_user_profiles_01` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
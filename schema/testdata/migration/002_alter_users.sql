@@ -0,0 +1,5 @@
+ALTER TABLE `users` ADD COLUMN `group_id` bigint(20) unsigned NOT NULL AFTER `id`, ADD INDEX `idx_group_id` (`group_id`);
+ALTER TABLE `users` DROP COLUMN `nickname`, DROP INDEX `idx_name`;
+ALTER TABLE `users` MODIFY COLUMN `name` varchar(64) DEFAULT NULL;
+ALTER TABLE `users` CHANGE COLUMN `created` `created_at` datetime NOT NULL;
+CREATE UNIQUE INDEX `uq_name` ON `users` (`name`);
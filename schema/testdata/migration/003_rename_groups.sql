@@ -0,0 +1,6 @@
+CREATE TABLE `tmp` (
+  `id` int NOT NULL
+);
+DROP TABLE `tmp`;
+RENAME TABLE `groups` TO `teams`;
+INSERT INTO `teams` (`name`) VALUES ('admin');
@@ -0,0 +1,6 @@
+-- groups managed by users
+CREATE TABLE IF NOT EXISTS public.groups (
+  id integer GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  name text NOT NULL UNIQUE,
+  updated_at timestamp with time zone
+);
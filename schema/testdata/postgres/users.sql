@@ -0,0 +1,15 @@
+CREATE TABLE "users" (
+  "id" bigserial NOT NULL,
+  "name" varchar(30) DEFAULT NULL,
+  "age" integer NOT NULL,
+  "score" double precision NOT NULL DEFAULT 0,
+  "tags" text[] NOT NULL DEFAULT '{}',
+  "profile" jsonb,
+  "icon" bytea,
+  "group_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uq_users_01" UNIQUE ("name")
+);
+
+CREATE INDEX "idx_users_02" ON "users" USING btree ("group_id");
@@ -0,0 +1,9 @@
+CREATE TYPE user_status AS ENUM ('active', 'banned');
+
+CREATE TABLE users (
+  id bigint GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  name varchar(30) NOT NULL,
+  nickname text
+);
+
+CREATE INDEX idx_users_name ON users (name);
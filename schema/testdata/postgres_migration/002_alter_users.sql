@@ -0,0 +1,7 @@
+ALTER TABLE users ADD COLUMN status user_status NOT NULL DEFAULT 'active', DROP COLUMN nickname;
+ALTER TABLE users ALTER COLUMN name DROP NOT NULL, ALTER COLUMN name TYPE text;
+ALTER TABLE users RENAME COLUMN name TO display_name;
+DROP INDEX IF EXISTS idx_users_name;
+ALTER TABLE users ADD CONSTRAINT uq_users_display_name UNIQUE (display_name);
+ALTER TABLE users RENAME TO accounts;
+COMMENT ON COLUMN accounts.status IS 'status of account';
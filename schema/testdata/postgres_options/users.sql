@@ -0,0 +1,15 @@
+CREATE TYPE user_status AS ENUM ('active', 'banned');
+
+CREATE TABLE users (
+  id bigserial PRIMARY KEY,
+  name varchar(30) NOT NULL DEFAULT 'guest'::character varying,
+  status user_status NOT NULL DEFAULT 'active',
+  role text NOT NULL CHECK (role IN ('admin', 'member')),
+  level integer NOT NULL DEFAULT 1 CHECK (level > 0),
+  is_public boolean NOT NULL DEFAULT true,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE users IS 'registered users';
+COMMENT ON COLUMN users.name IS 'display name';
+COMMENT ON COLUMN public.users.status IS 'status of account';
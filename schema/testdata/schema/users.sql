@@ -1,5 +1,5 @@
 CREATE TABLE `users` (
-  `id` bigint(20) unsigned NOT NULL,
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
   `name` varchar(30) DEFAULT NULL,
   `sex` enum('man','woman') NOT NULL,
   `age` int NOT NULL,
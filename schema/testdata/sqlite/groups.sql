@@ -0,0 +1,5 @@
+CREATE TABLE "groups" (
+  "id" integer NOT NULL PRIMARY KEY,
+  "name" text NOT NULL,
+  "owner_id" bigint
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `users` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(30) NOT NULL,
+  `admin` BOOLEAN NOT NULL DEFAULT 0,
+  `score` REAL,
+  `icon` BLOB,
+  `memo` TEXT,
+  `group_id` INTEGER NOT NULL REFERENCES `groups`(`id`),
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (`name`)
+);
+
+CREATE INDEX `idx_users_group_id` ON `users` (`group_id`);
@@ -0,0 +1,25 @@
+CREATE TABLE `samples` (
+  `id` bigint(20) unsigned NOT NULL,
+  `flag` tinyint(1) NOT NULL DEFAULT 1,
+  `small` smallint(6) NOT NULL,
+  `count` int(10) unsigned NOT NULL,
+  `amount` bigint(20) NOT NULL DEFAULT '100',
+  `rate` float NOT NULL DEFAULT -1.5,
+  `score` double NOT NULL,
+  `price` decimal(10,2) NOT NULL,
+  `born_on` date NOT NULL,
+  `played_at` datetime NOT NULL,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `duration` time NOT NULL,
+  `year` year NOT NULL,
+  `name` varchar(255) NOT NULL DEFAULT 'guest' COMMENT 'display name',
+  `body` mediumtext NOT NULL,
+  `kind` enum('a','b') NOT NULL DEFAULT 'b' COMMENT 'kind of sample',
+  `tags` set('x','y') NOT NULL,
+  `profile` json DEFAULT NULL,
+  `icon` blob,
+  `hash` binary(16) NOT NULL,
+  `bits` bit(8) NOT NULL,
+  `memo` varchar(255) DEFAULT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
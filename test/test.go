@@ -3,8 +3,6 @@ package test
 import (
 	"bytes"
 	"fmt"
-	"io/ioutil"
-	"os"
 	"path/filepath"
 	"sort"
 
@@ -32,8 +30,7 @@ func NewGenerator(cfg *config.Config) *Generator {
 }
 
 func (g *Generator) existsFile(path string) bool {
-	_, err := os.Stat(path)
-	return err == nil
+	return g.cfg.OutputWriter().Exists(path)
 }
 
 func (g *Generator) writeTestData(path string, class *types.Class) error {
@@ -49,7 +46,7 @@ func (g *Generator) writeTestData(path string, class *types.Class) error {
 		if err := yaml.NewEncoder(&buf, opt).Encode(defaultTestData); err != nil {
 			return xerrors.Errorf("failed to marshal default test data %s: %w", buf.String(), err)
 		}
-		if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		if err := g.cfg.OutputWriter().WriteFile(path, buf.Bytes(), 0644); err != nil {
 			return xerrors.Errorf("cannot write file %s: %w", path, err)
 		}
 		return nil
@@ -63,7 +60,7 @@ func (g *Generator) writeTestData(path string, class *types.Class) error {
 	if err := yaml.NewEncoder(&buf, opt).Encode(testData); err != nil {
 		return xerrors.Errorf("failed to marshal default test data %s: %w", buf.String(), err)
 	}
-	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(path, buf.Bytes(), 0644); err != nil {
 		return xerrors.Errorf("cannot write file %s: %w", path, err)
 	}
 	return nil
@@ -73,7 +70,7 @@ func (g *Generator) readTestData(path string) (*types.TestData, error) {
 	if !g.existsFile(path) {
 		return nil, nil
 	}
-	source, err := ioutil.ReadFile(path)
+	source, err := g.cfg.OutputWriter().ReadFile(path)
 	if err != nil {
 		return nil, xerrors.Errorf("failed to read test data file %s: %w", path, err)
 	}
@@ -221,11 +218,11 @@ func (g *Generator) generateByTestObjects(name string, objects []*types.TestObje
 func (g *Generator) writeFile(class *types.Class, basePath string, source []byte) error {
 	path := filepath.Join(basePath, fmt.Sprintf("%s.go", class.Name.SnakeName()))
 	if g.existsFile(path) {
-		if err := os.Remove(path); err != nil {
+		if err := g.cfg.OutputWriter().Remove(path); err != nil {
 			return xerrors.Errorf("failed to remove file %s: %w", path, err)
 		}
 	}
-	if err := ioutil.WriteFile(path, source, 0444); err != nil {
+	if err := g.cfg.OutputWriter().WriteFile(path, source, 0444); err != nil {
 		return xerrors.Errorf("cannot write file %s: %w", path, err)
 	}
 	return nil
@@ -279,23 +276,29 @@ func (g *Generator) GenerateMock(class *types.Class) error {
 	return nil
 }
 
-func (g *Generator) Generate(classes []*types.Class) error {
+// GenerateClass writes test data of class and generates factory of mock model by it
+func (g *Generator) GenerateClass(class *types.Class) error {
 	testDataPath := g.cfg.TestDataPath()
-	if err := os.MkdirAll(testDataPath, 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(testDataPath); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", testDataPath, err)
 	}
-	if err := os.MkdirAll(g.mockModelPath(), 0755); err != nil {
+	if err := g.cfg.OutputWriter().MkdirAll(g.mockModelPath()); err != nil {
 		return xerrors.Errorf("cannot create directory to %s: %w", g.mockModelPath(), err)
 	}
-	for _, class := range classes {
-		seedPath := filepath.Join(testDataPath, fmt.Sprintf("%s.yml", class.Name.SnakeName()))
-		if err := g.writeTestData(seedPath, class); err != nil {
-			return xerrors.Errorf("failed to write testdata: %w", err)
-		}
+	seedPath := filepath.Join(testDataPath, fmt.Sprintf("%s.yml", class.Name.SnakeName()))
+	if err := g.writeTestData(seedPath, class); err != nil {
+		return xerrors.Errorf("failed to write testdata: %w", err)
+	}
+	if err := g.GenerateMock(class); err != nil {
+		return xerrors.Errorf("failed to generate mock/model: %w", err)
 	}
+	return nil
+}
+
+func (g *Generator) Generate(classes []*types.Class) error {
 	for _, class := range classes {
-		if err := g.GenerateMock(class); err != nil {
-			return xerrors.Errorf("failed to generate mock/model: %w", err)
+		if err := g.GenerateClass(class); err != nil {
+			return xerrors.Errorf("failed to generate test for %s: %w", class.Name.SnakeName(), err)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ type DataAccessParam struct {
 	ClassName  func() *code.Statement
 	Receiver   func() *code.Statement
 	ImportList ImportList
+	Dialect    Dialect
 	SQL        *SQL
 }
 
@@ -48,14 +49,55 @@ type CreateParamArgs struct {
 	Value   func() *code.Statement
 }
 
+type CreateMultiParam struct {
+	DataAccessParam
+	Args *CreateMultiParamArgs
+	// ChunkSize max number of rows inserted by one query
+	ChunkSize int
+}
+
+type CreateMultiParamArgs struct {
+	Context func() *code.Statement
+	Values  func() *code.Statement
+	// Value returns each element of Values in loop
+	Value func() *code.Statement
+}
+
+type UpsertParam struct {
+	DataAccessParam
+	Args *CreateParamArgs
+	// ConflictMembers members of unique key ( or primary key ) to find existing record
+	ConflictMembers Members
+	// FindSQL query to get auto increment value of upserted record by ConflictMembers.
+	// it is nil unless upsert query cannot return it ( e.g. SQLite )
+	FindSQL *SQL
+}
+
+type UpsertMultiParam struct {
+	DataAccessParam
+	Args *CreateMultiParamArgs
+	// ChunkSize max number of rows upserted by one query
+	ChunkSize int
+	// ConflictMembers members of unique key ( or primary key ) to find existing record
+	ConflictMembers Members
+}
+
 type UpdateParam struct {
 	DataAccessParam
 	Args *UpdateParamArgs
+	// LockVersionMember member whose value is compared and incremented by Update for optimistic locking.
+	// UpdateBy methods increment it without comparing. it is nil unless class has member with lock_version.
+	LockVersionMember *Member
 }
 
 type UpdateParamArgs struct {
-	Context   func() *code.Statement
-	Value     func() *code.Statement
+	Context func() *code.Statement
+	Value   func() *code.Statement
+	// UpdateValue returns argument of UpdateBy methods to specify updated columns by entity ( e.g. *entity.UserUpdate ).
+	// it is nil if `dao.update_map` is enabled.
+	UpdateValue func() *code.Statement
+	// UpdateMap returns argument of UpdateBy methods to specify updated columns by map[string]interface{}.
+	// it is nil unless `dao.update_map` is enabled.
 	UpdateMap func() *code.Statement
 	Members   []*Member
 }
@@ -80,6 +122,28 @@ type FindParam struct {
 type FindParamArgs struct {
 	Context func() *code.Statement
 	Members []*Member
+	// Option returns argument to specify order and range of found records ( e.g. *entity.UserFindOption ).
+	// it is nil unless method is variant with option ( e.g. FindByGroupIDWithOption ).
+	Option func() *code.Statement
+	// Range operator to compare the last member ( e.g. FindByScoreGreaterThan ).
+	// it is empty if all members are compared by equality.
+	// BETWEEN takes two arguments for the last member, so the number of arguments is greater than Members.
+	Range RangeOperator
+	// Lock row lock taken by locking read ( e.g. FindByIDForUpdate ).
+	// it is empty unless class enables `locking_read`.
+	Lock LockMode
+}
+
+type QueryParam struct {
+	DataAccessParam
+	Args *QueryParamArgs
+	// Query declared in class file. SQL has query whose named parameters are replaced by placeholders.
+	Query               *Query
+	IsSingleReturnValue bool
+}
+
+type QueryParamArgs struct {
+	Context func() *code.Statement
 }
 
 type CountParam struct {
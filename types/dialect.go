@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"go.knocknote.io/eevee/code"
+)
+
+// Dialect represents SQL dialect of the database accessed by generated code
+type Dialect string
+
+const (
+	DialectMySQL    Dialect = "mysql"
+	DialectPostgres Dialect = "postgres"
+	DialectSQLite   Dialect = "sqlite"
+)
+
+// Quote escapes identifier like table name or column name.
+// MySQL uses backquote ( `name` ) and PostgreSQL or SQLite uses double quote ( "name" ).
+func (d Dialect) Quote(name string) string {
+	if d == DialectPostgres || d == DialectSQLite {
+		return fmt.Sprintf(`"%s"`, name)
+	}
+	return fmt.Sprintf("`%s`", name)
+}
+
+// QuoteFormat returns format string for fmt.Sprintf to escape identifier at runtime
+func (d Dialect) QuoteFormat() string {
+	return d.Quote("%s")
+}
+
+// Placeholder returns bind variable for n-th ( 1-origin ) argument
+func (d Dialect) Placeholder(n int) string {
+	if d == DialectPostgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// IsNumberedPlaceholder whether dialect uses placeholder with index like $1, $2
+func (d Dialect) IsNumberedPlaceholder() bool {
+	return d == DialectPostgres
+}
+
+// OmitsGeneratedID whether INSERT statement should not contain column assigned by database.
+// MySQL assigns AUTO_INCREMENT value when inserting 0, but PostgreSQL and SQLite store 0 as it is.
+func (d Dialect) OmitsGeneratedID() bool {
+	return d == DialectPostgres || d == DialectSQLite
+}
+
+// SupportsReturning whether dialect supports INSERT ... RETURNING clause
+func (d Dialect) SupportsReturning() bool {
+	return d == DialectPostgres
+}
+
+// LastInsertIDIsLastRow whether LastInsertId of multi-row INSERT returns ID of the last row.
+// MySQL returns ID of the first row and SQLite returns ID of the last row.
+func (d Dialect) LastInsertIDIsLastRow() bool {
+	return d == DialectSQLite
+}
+
+// UpsertClause returns clause to update existing record by INSERT statement.
+// MySQL uses ON DUPLICATE KEY UPDATE ( conflict is detected by all unique keys ),
+// and PostgreSQL or SQLite uses ON CONFLICT (conflictColumns) DO UPDATE.
+// if autoIncrementColumn is specified, MySQL assigns ID of updated record to LAST_INSERT_ID by it.
+// if versionColumn is specified, it is incremented instead of being overwritten by inserted value.
+func (d Dialect) UpsertClause(table string, conflictColumns, updateColumns []string, autoIncrementColumn, versionColumn string) string {
+	if len(updateColumns) == 0 && versionColumn == "" {
+		// update nothing, but record should be updated to return ID of it
+		updateColumns = conflictColumns
+	}
+	assigns := []string{}
+	if d == DialectPostgres || d == DialectSQLite {
+		for _, column := range updateColumns {
+			assigns = append(assigns, fmt.Sprintf("%s = excluded.%s", d.Quote(column), d.Quote(column)))
+		}
+		if versionColumn != "" {
+			// column of existing record is qualified by table name not to be ambiguous with excluded
+			assigns = append(assigns, fmt.Sprintf("%s = %s.%s + 1", d.Quote(versionColumn), d.Quote(table), d.Quote(versionColumn)))
+		}
+		quotedColumns := []string{}
+		for _, column := range conflictColumns {
+			quotedColumns = append(quotedColumns, d.Quote(column))
+		}
+		return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(quotedColumns, ", "), strings.Join(assigns, ", "))
+	}
+	if autoIncrementColumn != "" {
+		assigns = append(assigns, fmt.Sprintf("%s = LAST_INSERT_ID(%s)", d.Quote(autoIncrementColumn), d.Quote(autoIncrementColumn)))
+	}
+	for _, column := range updateColumns {
+		assigns = append(assigns, fmt.Sprintf("%s = VALUES(%s)", d.Quote(column), d.Quote(column)))
+	}
+	if versionColumn != "" {
+		assigns = append(assigns, fmt.Sprintf("%s = %s + 1", d.Quote(versionColumn), d.Quote(versionColumn)))
+	}
+	return fmt.Sprintf("ON DUPLICATE KEY UPDATE %s", strings.Join(assigns, ", "))
+}
+
+// ReturnsLastInsertIDByUpsert whether LastInsertId returns ID of updated record by upsert query.
+// SQLite doesn't change it when existing record is updated.
+func (d Dialect) ReturnsLastInsertIDByUpsert() bool {
+	return d != DialectPostgres && d != DialectSQLite
+}
+
+// SupportsLockingRead whether dialect supports row lock by SELECT statement.
+// SQLite doesn't support row lock and serializes writes by database lock.
+func (d Dialect) SupportsLockingRead() bool {
+	return d != DialectSQLite
+}
+
+// LockClause returns clause added to SELECT statement to take row lock
+func (d Dialect) LockClause(mode LockMode) string {
+	switch d {
+	case DialectPostgres:
+		if mode == LockForShare {
+			return "FOR SHARE"
+		}
+		return "FOR UPDATE"
+	}
+	if mode == LockForShare {
+		return "LOCK IN SHARE MODE"
+	}
+	return "FOR UPDATE"
+}
+
+// NoLimit returns value of LIMIT clause to get all records.
+// MySQL and SQLite cannot use OFFSET clause without LIMIT clause.
+func (d Dialect) NoLimit() string {
+	switch d {
+	case DialectPostgres:
+		return "ALL"
+	case DialectSQLite:
+		return "-1"
+	}
+	return "18446744073709551615"
+}
+
+// IsArrayType whether type is mapped to array column ( e.g. text[] of PostgreSQL )
+func (d Dialect) IsArrayType(decl *TypeDeclare) bool {
+	if d != DialectPostgres {
+		return false
+	}
+	name := decl.Name()
+	return strings.HasPrefix(name, "[]") && name != "[]byte"
+}
+
+// ValueCode returns code to pass value to database/sql.
+// Array value is wrapped by pq.Array because database/sql cannot handle it directly.
+func (d Dialect) ValueCode(decl *TypeDeclare, value *code.Statement) code.Code {
+	if d.IsArrayType(decl) {
+		return code.Qual("github.com/lib/pq", "Array").Call(value)
+	}
+	return value
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// EnumConstant constant of enum type generated to entity package
+type EnumConstant struct {
+	Name  string
+	Value string
+}
+
+// ResolveEnumTypes replaces string type of member that declares enum values with named type in entity package.
+// type is named by member name ( e.g. Sex ).
+// if the same name is used by multiple classes or conflicts with class name, class name is added as prefix ( e.g. UserStatus ).
+func ResolveEnumTypes(classes []*Class) {
+	nameCount := map[string]int{}
+	for _, class := range classes {
+		nameCount[class.Name.CamelName()]++
+		nameCount[class.Name.PluralCamelName()]++
+		for _, member := range class.enumMembers() {
+			nameCount[member.Name.CamelName()]++
+		}
+	}
+	for _, class := range classes {
+		for _, member := range class.enumMembers() {
+			name := member.Name.CamelName()
+			if nameCount[name] > 1 {
+				name = class.Name.CamelName() + name
+			}
+			member.Type = &TypeDeclare{
+				Type: &Type{
+					PackageName:  "entity",
+					Name:         name,
+					As:           StringType.Name,
+					IsPrimitive:  true,
+					IsEnum:       true,
+					DefaultValue: StringType.DefaultValue,
+				},
+				IsPointer:   member.Type.IsPointer,
+				classMap:    member.Type.classMap,
+				subClassMap: member.Type.subClassMap,
+			}
+		}
+	}
+}
+
+func (c *Class) enumMembers() Members {
+	members := Members{}
+	for _, member := range c.Members {
+		if member.Extend || member.Relation != nil || len(member.Enum) == 0 {
+			continue
+		}
+		if member.Type == nil || member.Type.Type.Name != StringType.Name || member.Type.IsSlice {
+			continue
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// EnumMembers returns members typed by enum type
+func (c *Class) EnumMembers() Members {
+	members := Members{}
+	for _, member := range c.Members {
+		if member.IsEnumType() {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// IsEnumType whether member type is enum type generated by ResolveEnumTypes
+func (m *Member) IsEnumType() bool {
+	return m.Type != nil && m.Type.Type != nil && m.Type.Type.IsEnum
+}
+
+// EnumConstants returns constants of enum type.
+// constant is named by type name and value ( e.g. SexMan for 'man' of Sex ).
+// if value cannot be used for identifier, index of value is used instead.
+func (m *Member) EnumConstants() []*EnumConstant {
+	typeName := m.Type.Name()
+	constants := []*EnumConstant{}
+	usedNames := map[string]struct{}{}
+	for idx, value := range m.Enum {
+		name := enumIdentifier(value)
+		if _, exists := usedNames[name]; exists || name == "" {
+			name = fmt.Sprintf("Value%d", idx)
+		}
+		usedNames[name] = struct{}{}
+		constants = append(constants, &EnumConstant{
+			Name:  typeName + name,
+			Value: value,
+		})
+	}
+	return constants
+}
+
+func enumIdentifier(value string) string {
+	normalized := []rune{}
+	for _, r := range value {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			normalized = append(normalized, r)
+		} else {
+			normalized = append(normalized, '_')
+		}
+	}
+	return Name(string(normalized)).CamelName()
+}
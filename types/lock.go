@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+)
+
+// LockMode row lock taken by locking read ( e.g. FindByIDForUpdate )
+type LockMode string
+
+const (
+	// LockForUpdate exclusive lock to update found records
+	LockForUpdate LockMode = "update"
+	// LockForShare shared lock to prevent found records from being updated by other transactions
+	LockForShare LockMode = "share"
+)
+
+// LockModes modes of locking read generated for class which enables `locking_read`
+var LockModes = []LockMode{LockForUpdate, LockForShare}
+
+// MethodSuffix returns suffix of finder name ( e.g. ForUpdate )
+func (m LockMode) MethodSuffix() string {
+	return fmt.Sprintf("For%s", strcase.ToCamel(string(m)))
+}
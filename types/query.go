@@ -0,0 +1,112 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// QueryReturn number of records returned by query declared in class file
+type QueryReturn string
+
+const (
+	// QueryReturnOne method returns a record or nil ( e.g. *entity.User )
+	QueryReturnOne QueryReturn = "one"
+	// QueryReturnMany method returns records ( e.g. entity.Users )
+	QueryReturnMany QueryReturn = "many"
+)
+
+// Query named SQL declared in class file.
+// parameters are referred as `:name` in SQL.
+type Query struct {
+	Name   Name              `yaml:"name"`
+	SQL    string            `yaml:"sql"`
+	Params []*QueryParameter `yaml:"params,omitempty"`
+	Return QueryReturn       `yaml:"return,omitempty"`
+}
+
+// QueryParameter typed parameter of query
+type QueryParameter struct {
+	Name Name         `yaml:"name"`
+	Type *TypeDeclare `yaml:"type"`
+}
+
+var selectAllPattern = regexp.MustCompile(`(?is)^(\s*SELECT\s+)\*(\s)`)
+
+// MethodName returns name of generated method. it must start with Find.
+func (q *Query) MethodName() string {
+	return q.Name.CamelName()
+}
+
+// IsSingleReturnValue whether method returns a record instead of records
+func (q *Query) IsSingleReturnValue() bool {
+	return q.Return == QueryReturnOne
+}
+
+// Validate checks name and return of query
+func (q *Query) Validate() error {
+	if !strings.HasPrefix(q.MethodName(), "Find") {
+		return xerrors.Errorf("name of query must start with find: %s", q.Name)
+	}
+	if q.Return != "" && q.Return != QueryReturnOne && q.Return != QueryReturnMany {
+		return xerrors.Errorf("unknown return %s of query %s. it must be one or many", q.Return, q.Name)
+	}
+	if strings.TrimSpace(q.SQL) == "" {
+		return xerrors.Errorf("sql of query %s is empty", q.Name)
+	}
+	return nil
+}
+
+// Bind replaces `*` of `SELECT *` by columns and named parameters by placeholders of dialect.
+// it returns parameters in order of appearance, so the same parameter is contained as many times as it appears.
+// `::` ( cast of PostgreSQL ) and string literals are not replaced.
+func (q *Query) Bind(dialect Dialect, columns []string) (string, []*QueryParameter, error) {
+	paramMap := map[string]*QueryParameter{}
+	for _, param := range q.Params {
+		paramMap[param.Name.SnakeName()] = param
+	}
+	src := selectAllPattern.ReplaceAllString(q.SQL, "${1}"+strings.Join(columns, ", ")+"${2}")
+	var query strings.Builder
+	params := []*QueryParameter{}
+	usedParams := map[string]struct{}{}
+	inString := false
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '\'':
+			inString = !inString
+		case inString || c != ':':
+		case i+1 < len(src) && src[i+1] == ':':
+			query.WriteString("::")
+			i++
+			continue
+		case i+1 < len(src) && isQueryParamChar(src[i+1]):
+			end := i + 1
+			for end < len(src) && isQueryParamChar(src[end]) {
+				end++
+			}
+			name := src[i+1 : end]
+			param, exists := paramMap[name]
+			if !exists {
+				return "", nil, xerrors.Errorf("unknown parameter :%s in query %s", name, q.Name)
+			}
+			params = append(params, param)
+			usedParams[name] = struct{}{}
+			query.WriteString(dialect.Placeholder(len(params)))
+			i = end - 1
+			continue
+		}
+		query.WriteByte(c)
+	}
+	for _, param := range q.Params {
+		if _, exists := usedParams[param.Name.SnakeName()]; !exists {
+			return "", nil, xerrors.Errorf("parameter %s is not used in query %s", param.Name, q.Name)
+		}
+	}
+	return query.String(), params, nil
+}
+
+func isQueryParamChar(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
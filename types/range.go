@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+)
+
+// RangeOperator comparison operator for the last column of key used by range finder ( e.g. FindByScoreGreaterThan )
+type RangeOperator string
+
+const (
+	RangeBetween            RangeOperator = "between"
+	RangeGreaterThan        RangeOperator = "greater_than"
+	RangeGreaterThanOrEqual RangeOperator = "greater_than_or_equal"
+	RangeLessThan           RangeOperator = "less_than"
+	RangeLessThanOrEqual    RangeOperator = "less_than_or_equal"
+)
+
+var rangeOperatorSQLMap = map[RangeOperator]string{
+	RangeGreaterThan:        ">",
+	RangeGreaterThanOrEqual: ">=",
+	RangeLessThan:           "<",
+	RangeLessThanOrEqual:    "<=",
+}
+
+// IsValid whether operator is supported
+func (o RangeOperator) IsValid() bool {
+	if o == RangeBetween {
+		return true
+	}
+	_, exists := rangeOperatorSQLMap[o]
+	return exists
+}
+
+// MethodSuffix returns suffix of finder name ( e.g. GreaterThan )
+func (o RangeOperator) MethodSuffix() string {
+	return strcase.ToCamel(string(o))
+}
+
+// ArgNum returns number of arguments to compare. BETWEEN requires lower and upper bound.
+func (o RangeOperator) ArgNum() int {
+	if o == RangeBetween {
+		return 2
+	}
+	return 1
+}
+
+// Condition returns SQL condition for quoted column.
+// BETWEEN includes both lower and upper bound.
+func (o RangeOperator) Condition(column string, placeholders ...string) string {
+	if o == RangeBetween {
+		return fmt.Sprintf("%s BETWEEN %s AND %s", column, placeholders[0], placeholders[1])
+	}
+	return fmt.Sprintf("%s %s %s", column, rangeOperatorSQLMap[o], placeholders[0])
+}
+
+// RangeKey key whose last member is searched by range condition and the others are searched by equality condition
+type RangeKey struct {
+	Members  Members
+	Operator RangeOperator
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,60 +16,163 @@ import (
 )
 
 type INDEX struct {
-	PrimaryKey string       `yaml:"primary_key"`
+	PrimaryKey PrimaryKey   `yaml:"primary_key"`
 	UniqueKeys []*UniqueKey `yaml:"unique_keys,omitempty"`
 	Keys       []*Key       `yaml:"keys,omitempty"`
 }
 
-type UniqueKey struct {
+// PrimaryKey columns of primary key.
+// single column is written as `primary_key: id`,
+// and composite primary key is written as `primary_key: [user_id, item_id]`
+type PrimaryKey struct {
 	Columns []string
 }
 
-func (k *UniqueKey) MarshalYAML() (interface{}, error) {
+func NewPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{Columns: columns}
+}
+
+// IsComposite whether primary key consists of multiple columns
+func (k PrimaryKey) IsComposite() bool {
+	return len(k.Columns) > 1
+}
+
+// Contains whether column is a part of primary key
+func (k PrimaryKey) Contains(column string) bool {
+	for _, c := range k.Columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+func (k PrimaryKey) MarshalYAML() (interface{}, error) {
+	switch len(k.Columns) {
+	case 0:
+		return "", nil
+	case 1:
+		return k.Columns[0], nil
+	}
 	return k.Columns, nil
 }
 
-func (k *UniqueKey) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (k *PrimaryKey) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var column string
+	if err := unmarshal(&column); err == nil {
+		k.Columns = nil
+		if column != "" {
+			k.Columns = []string{column}
+		}
+		return nil
+	}
 	if err := unmarshal(&k.Columns); err != nil {
 		return xerrors.Errorf("cannot unmarshal Columns: %w", err)
 	}
 	return nil
 }
 
+// UniqueKey columns of unique key. Name is index name in schema and it is not written to class file.
+// Range declares range finders for the last column ( see Key ).
+type UniqueKey struct {
+	Name    string
+	Columns []string
+	Range   []RangeOperator
+}
+
+func (k *UniqueKey) MarshalYAML() (interface{}, error) {
+	return marshalKey(k.Columns, k.Range)
+}
+
+func (k *UniqueKey) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	columns, ranges, err := unmarshalKey(unmarshal)
+	if err != nil {
+		return xerrors.Errorf("cannot unmarshal unique key: %w", err)
+	}
+	k.Columns = columns
+	k.Range = ranges
+	return nil
+}
+
+// Key columns of key. Name is index name in schema and it is not written to class file.
+// key is written as list of columns, or the following style to declare range finders for the last column.
+//
+//   - columns: [user_id, created_at]
+//     range: [between]
 type Key struct {
+	Name    string
 	Columns []string
+	Range   []RangeOperator
 }
 
 func (k *Key) MarshalYAML() (interface{}, error) {
-	return k.Columns, nil
+	return marshalKey(k.Columns, k.Range)
 }
 
 func (k *Key) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	if err := unmarshal(&k.Columns); err != nil {
-		return xerrors.Errorf("cannot unmarshal Columns: %w", err)
+	columns, ranges, err := unmarshalKey(unmarshal)
+	if err != nil {
+		return xerrors.Errorf("cannot unmarshal key: %w", err)
 	}
+	k.Columns = columns
+	k.Range = ranges
 	return nil
 }
 
+type keyWithRange struct {
+	Columns []string        `yaml:"columns"`
+	Range   []RangeOperator `yaml:"range,omitempty"`
+}
+
+func marshalKey(columns []string, ranges []RangeOperator) (interface{}, error) {
+	if len(ranges) == 0 {
+		return columns, nil
+	}
+	return &keyWithRange{Columns: columns, Range: ranges}, nil
+}
+
+func unmarshalKey(unmarshal func(interface{}) error) ([]string, []RangeOperator, error) {
+	var columns []string
+	if err := unmarshal(&columns); err == nil {
+		return columns, nil, nil
+	}
+	var key keyWithRange
+	if err := unmarshal(&key); err != nil {
+		return nil, nil, xerrors.Errorf("cannot unmarshal Columns: %w", err)
+	}
+	for _, operator := range key.Range {
+		if !operator.IsValid() {
+			return nil, nil, xerrors.Errorf("unknown range operator %s for %v", operator, key.Columns)
+		}
+	}
+	return key.Columns, key.Range, nil
+}
+
 type Class struct {
-	Name      Name               `yaml:"name"`
-	DataStore string             `yaml:"datastore"`
-	Index     *INDEX             `yaml:"index"`
-	ReadOnly  bool               `yaml:"read_only,omitempty"`
-	Members   []*Member          `yaml:"members"`
-	classMap  *map[string]*Class `yaml:"-"`
+	Name        Name               `yaml:"name"`
+	DataStore   string             `yaml:"datastore"`
+	Index       *INDEX             `yaml:"index"`
+	ReadOnly    bool               `yaml:"read_only,omitempty"`
+	LockingRead bool               `yaml:"locking_read,omitempty"`
+	Members     []*Member          `yaml:"members"`
+	Queries     []*Query           `yaml:"queries,omitempty"`
+	classMap    *map[string]*Class `yaml:"-"`
 }
 
 type Member struct {
-	Name        Name         `yaml:"name"`
-	Type        *TypeDeclare `yaml:"type,omitempty"`
-	Extend      bool         `yaml:"extend,omitempty"`
-	Render      *Render      `yaml:"render,omitempty"`
-	HasMany     bool         `yaml:"has_many,omitempty"`
-	Nullable    bool         `yaml:"nullable,omitempty"`
-	Description string       `yaml:"desc,omitempty"`
-	Example     interface{}  `yaml:"example,omitempty"`
-	Relation    *Relation    `yaml:"relation,omitempty"`
+	Name          Name         `yaml:"name"`
+	Type          *TypeDeclare `yaml:"type,omitempty"`
+	Extend        bool         `yaml:"extend,omitempty"`
+	Render        *Render      `yaml:"render,omitempty"`
+	HasMany       bool         `yaml:"has_many,omitempty"`
+	Nullable      bool         `yaml:"nullable,omitempty"`
+	AutoIncrement bool         `yaml:"auto_increment,omitempty"`
+	LockVersion   bool         `yaml:"lock_version,omitempty"`
+	Description   string       `yaml:"desc,omitempty"`
+	Example       interface{}  `yaml:"example,omitempty"`
+	Default       interface{}  `yaml:"default,omitempty"`
+	Enum          []string     `yaml:"enum,omitempty"`
+	Relation      *Relation    `yaml:"relation,omitempty"`
 }
 
 type Relation struct {
@@ -153,6 +257,7 @@ type Type struct {
 	ImportPath   string
 	As           string
 	IsPrimitive  bool
+	IsEnum       bool
 	DefaultValue interface{}
 }
 
@@ -317,7 +422,9 @@ func (d *TypeDeclare) Code(importList ImportList) code.Code {
 	if d.IsSlice {
 		c = c.Index()
 	}
-	if d.Type.PackageName != "" {
+	if d.Type.ImportPath != "" {
+		c = c.Qual(d.Type.ImportPath, d.Type.Name)
+	} else if d.Type.PackageName != "" {
 		c = c.Qual(importList.Package(d.Type.PackageName), d.Type.Name)
 	} else {
 		c = c.Id(d.Type.Name)
@@ -488,6 +595,16 @@ func (m Members) Names() []Name {
 	return names
 }
 
+// Contains whether member is included in members
+func (m Members) Contains(member *Member) bool {
+	for _, mem := range m {
+		if mem == member {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Class) FileName() string {
 	return c.Name.SnakeName()
 }
@@ -515,6 +632,88 @@ func (c *Class) RelationMembers() Members {
 	return members
 }
 
+// ColumnMembers returns members stored in columns of table
+func (c *Class) ColumnMembers() Members {
+	members := Members{}
+	for _, member := range c.Members {
+		if member.Relation != nil {
+			continue
+		}
+		if member.Extend {
+			continue
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// UpdateColumnMembers returns members whose columns are able to be specified by UpdateBy methods.
+// member with lock_version is excluded because its value is incremented by database.
+func (c *Class) UpdateColumnMembers() Members {
+	members := Members{}
+	for _, member := range c.ColumnMembers() {
+		if member.LockVersion {
+			continue
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// UpdateStructName returns name of entity to specify columns and values updated by UpdateBy methods
+func (c *Class) UpdateStructName() string {
+	return fmt.Sprintf("%sUpdate", c.Name.CamelName())
+}
+
+// FindOptionStructName returns name of entity to specify order and range of records found by WithOption methods
+func (c *Class) FindOptionStructName() string {
+	return fmt.Sprintf("%sFindOption", c.Name.CamelName())
+}
+
+// OrderColumnTypeName returns name of type to specify column for ORDER BY
+func (c *Class) OrderColumnTypeName() string {
+	return fmt.Sprintf("%sOrderColumn", c.Name.CamelName())
+}
+
+// OrderColumnName returns name of constant to specify member's column for ORDER BY
+func (c *Class) OrderColumnName(member *Member) string {
+	return fmt.Sprintf("%sOrderBy%s", c.Name.CamelName(), member.Name.CamelName())
+}
+
+// OrderMembers returns indexed members which are able to be used for ORDER BY with declared order.
+// primary key is used to decide order of records which have the same value,
+// so returns empty if primary key is not defined or not orderable.
+func (c *Class) OrderMembers() Members {
+	primaryKey := c.PrimaryKeys()
+	if len(primaryKey) == 0 {
+		return Members{}
+	}
+	for _, member := range primaryKey {
+		if !member.IsOrderable() {
+			return Members{}
+		}
+	}
+	keys := []Members{primaryKey}
+	keys = append(keys, c.UniqueKeys()...)
+	keys = append(keys, c.Keys()...)
+	memberMap := map[*Member]struct{}{}
+	for _, key := range keys {
+		for _, member := range key {
+			if member == nil || !member.IsOrderable() {
+				continue
+			}
+			memberMap[member] = struct{}{}
+		}
+	}
+	members := Members{}
+	for _, member := range c.Members {
+		if _, exists := memberMap[member]; exists {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
 func (c *Class) DependencyMembers() []*Member {
 	members := []*Member{}
 	for _, member := range c.RelationMembers() {
@@ -560,8 +759,58 @@ func (c *Class) MarshalRelation() ([]byte, error) {
 	return bytes, nil
 }
 
+// PrimaryKey returns member of primary key.
+// if primary key is not defined or composite primary key, returns nil
 func (c *Class) PrimaryKey() *Member {
-	return c.MemberByName(c.Index.PrimaryKey)
+	if len(c.Index.PrimaryKey.Columns) != 1 {
+		return nil
+	}
+	return c.MemberByName(c.Index.PrimaryKey.Columns[0])
+}
+
+// PrimaryKeys returns members of primary key with declared order
+func (c *Class) PrimaryKeys() Members {
+	members := Members{}
+	for _, column := range c.Index.PrimaryKey.Columns {
+		if member := c.MemberByName(column); member != nil {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// AutoIncrementMember returns member whose value is assigned by database when inserting record.
+// returns nil if class doesn't have AUTO_INCREMENT ( serial, identity or rowid ) column.
+func (c *Class) AutoIncrementMember() *Member {
+	for _, member := range c.Members {
+		if member.Extend || member.Relation != nil {
+			continue
+		}
+		if member.AutoIncrement {
+			return member
+		}
+	}
+	return nil
+}
+
+// LockVersionMember returns member to detect conflict of Update by optimistic locking.
+// it returns nil if class doesn't have member with lock_version.
+func (c *Class) LockVersionMember() *Member {
+	for _, member := range c.Members {
+		if member.Extend || member.Relation != nil {
+			continue
+		}
+		if member.LockVersion {
+			return member
+		}
+	}
+	return nil
+}
+
+// CreatedAtMember returns member that keeps the time when record was created ( member named created_at ).
+// upsert doesn't overwrite its value of existing record. it returns nil if class doesn't have it.
+func (c *Class) CreatedAtMember() *Member {
+	return c.MemberByName("created_at")
 }
 
 func (c *Class) UniqueKeys() []Members {
@@ -588,6 +837,27 @@ func (c *Class) Keys() []Members {
 	return keys
 }
 
+// RangeKeys returns keys declared range finders for each operator
+func (c *Class) RangeKeys() []*RangeKey {
+	rangeKeys := []*RangeKey{}
+	appendRangeKeys := func(columns []string, ranges []RangeOperator) {
+		members := Members{}
+		for _, column := range columns {
+			members = append(members, c.MemberByName(column))
+		}
+		for _, operator := range ranges {
+			rangeKeys = append(rangeKeys, &RangeKey{Members: members, Operator: operator})
+		}
+	}
+	for _, key := range c.Index.UniqueKeys {
+		appendRangeKeys(key.Columns, key.Range)
+	}
+	for _, key := range c.Index.Keys {
+		appendRangeKeys(key.Columns, key.Range)
+	}
+	return rangeKeys
+}
+
 func (c *Class) MemberByName(name string) *Member {
 	for _, member := range c.Members {
 		if member.Name.SnakeName() == name {
@@ -634,7 +904,7 @@ func (c *Class) ResolveTypeReference() error {
 }
 
 func (c *Class) Merge(schema *Class) {
-	c.Index = schema.Index
+	c.mergeIndex(schema.Index)
 	schemaMemberMap := map[string]*Member{}
 	for _, member := range schema.Members {
 		schemaMemberMap[member.Name.SnakeName()] = member
@@ -646,12 +916,15 @@ func (c *Class) Merge(schema *Class) {
 	for _, member := range c.Members {
 		if member.Extend {
 			extendMembers = append(extendMembers, member)
+			memberNameMap[member.Name.SnakeName()] = struct{}{}
 			continue
 		}
-		if _, exists := schemaMemberMap[member.Name.SnakeName()]; !exists {
+		schemaMember, exists := schemaMemberMap[member.Name.SnakeName()]
+		if !exists {
 			// remove member
 			continue
 		}
+		member.mergeSchema(schemaMember)
 		mergedMembers = append(mergedMembers, member)
 		memberNameMap[member.Name.SnakeName()] = struct{}{}
 	}
@@ -659,13 +932,72 @@ func (c *Class) Merge(schema *Class) {
 		if _, exists := memberNameMap[member.Name.SnakeName()]; exists {
 			continue
 		}
-
+		if member.Extend {
+			// relation inferred from schema. if already defined the same relation by other name, keep it.
+			if member.Relation != nil && c.hasRelation(member.Relation) {
+				continue
+			}
+			extendMembers = append(extendMembers, member)
+			continue
+		}
 		mergedMembers = append(mergedMembers, member)
 	}
 	mergedMembers = append(mergedMembers, extendMembers...)
 	c.Members = mergedMembers
 }
 
+// mergeIndex reflects index declared in schema.
+// range finders declared in class file are kept for the key which has the same columns.
+func (c *Class) mergeIndex(index *INDEX) {
+	rangeMap := map[string][]RangeOperator{}
+	if c.Index != nil && index != nil {
+		for _, key := range c.Index.UniqueKeys {
+			rangeMap[strings.Join(key.Columns, ":")] = key.Range
+		}
+		for _, key := range c.Index.Keys {
+			rangeMap[strings.Join(key.Columns, ":")] = key.Range
+		}
+		for _, key := range index.UniqueKeys {
+			key.Range = rangeMap[strings.Join(key.Columns, ":")]
+		}
+		for _, key := range index.Keys {
+			key.Range = rangeMap[strings.Join(key.Columns, ":")]
+		}
+	}
+	c.Index = index
+}
+
+// mergeSchema reflects properties declared in schema.
+// description is used only if it is not written in class file.
+func (m *Member) mergeSchema(schema *Member) {
+	m.AutoIncrement = schema.AutoIncrement
+	if m.Description == "" {
+		m.Description = schema.Description
+	}
+	if schema.Default != nil {
+		m.Default = schema.Default
+	}
+	if len(schema.Enum) > 0 {
+		m.Enum = schema.Enum
+	}
+}
+
+func (c *Class) hasRelation(relation *Relation) bool {
+	for _, member := range c.RelationMembers() {
+		if member.Relation.To.SnakeName() != relation.To.SnakeName() {
+			continue
+		}
+		if member.Relation.Custom || member.Relation.All {
+			continue
+		}
+		if member.Relation.Internal.SnakeName() == relation.Internal.SnakeName() &&
+			member.Relation.External.SnakeName() == relation.External.SnakeName() {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Class) TestData() *TestData {
 	defaultObject := c.DefaultTestObject()
 	return &TestData{
@@ -681,19 +1013,40 @@ func (c *Class) TestData() *TestData {
 func (c *Class) DefaultTestObject() *TestObject {
 	mapValue := map[string]interface{}{}
 	for _, member := range c.Members {
-		name := member.Name.SnakeName()
-		example := member.Example
-		if example == nil {
-			mapValue[name] = member.Type.DefaultValue()
-		} else {
-			mapValue[name] = example
-		}
+		mapValue[member.Name.SnakeName()] = member.ExampleValue()
 	}
 	return &TestObject{
 		MapValue: mapValue,
 	}
 }
 
+// ExampleValue value of member for test data or API document.
+// example property is used preferentially, then default value and the first value of enum.
+func (m *Member) ExampleValue() interface{} {
+	if m.Example != nil {
+		return m.Example
+	}
+	if m.Default != nil {
+		return m.Default
+	}
+	if len(m.Enum) > 0 {
+		return m.Enum[0]
+	}
+	return m.Type.DefaultValue()
+}
+
+// DescriptionWithEnum description of member with available values
+func (m *Member) DescriptionWithEnum() string {
+	if len(m.Enum) == 0 {
+		return m.Description
+	}
+	enum := fmt.Sprintf("( %s )", strings.Join(m.Enum, " / "))
+	if m.Description == "" {
+		return enum
+	}
+	return fmt.Sprintf("%s %s", m.Description, enum)
+}
+
 func (m *Member) RenderProtocols() []string {
 	protocols := []string{"json"}
 	if m.Render == nil {
@@ -725,7 +1078,46 @@ func (m *Member) RenderNameByProtocol(requiredProtocol string) string {
 	return m.Name.CamelLowerName()
 }
 
+// UpdateMapKeys returns keys of map for UpdateBy methods that are accepted as this member's column.
+// they are column name, member name in camel case and render names.
+func (m *Member) UpdateMapKeys() []string {
+	keys := []string{m.Name.SnakeName()}
+	keyMap := map[string]struct{}{m.Name.SnakeName(): {}}
+	candidates := []string{m.Name.CamelName(), m.Name.CamelLowerName()}
+	for _, proto := range m.RenderProtocols() {
+		candidates = append(candidates, m.RenderNameByProtocol(proto))
+	}
+	sort.Strings(candidates)
+	for _, key := range candidates {
+		if key == "-" {
+			continue
+		}
+		if _, exists := keyMap[key]; exists {
+			continue
+		}
+		keyMap[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// IsOrderable returns whether records are able to be sorted by this member's column.
+// nullable column is excluded because the order of NULL is different for each database.
+func (m *Member) IsOrderable() bool {
+	if m.Extend || m.Relation != nil || m.Nullable {
+		return false
+	}
+	if m.Type.IsPointer || m.Type.IsSlice {
+		return false
+	}
+	typ := m.Type.Type
+	return typ.IsInt() || typ.IsUint() || typ.IsFloat() || typ.IsString() || typ.IsTime()
+}
+
 func (m *Member) CamelType() string {
+	if m.Type.IsCustomPrimitiveType() {
+		return strcase.ToCamel(m.Type.Type.As)
+	}
 	return strcase.ToCamel(m.Type.Type.Name)
 }
 
@@ -785,9 +1177,26 @@ func (m *Member) Validate() error {
 	if m.Type == nil && m.Relation == nil {
 		return xerrors.Errorf("undefined %s member type. required type property", m.Name.SnakeName())
 	}
+	if m.AutoIncrement && m.Type != nil && !m.Type.Type.IsInt() && !m.Type.Type.IsUint() {
+		return xerrors.Errorf("auto_increment member %s must be integer type. but specified %s", m.Name.SnakeName(), m.Type.Name())
+	}
+	if len(m.Enum) > 0 && m.Default != nil {
+		if !m.isEnumValue(fmt.Sprint(m.Default)) {
+			return xerrors.Errorf("default value of %s member must be one of %v. but specified %v", m.Name.SnakeName(), m.Enum, m.Default)
+		}
+	}
 	return nil
 }
 
+func (m *Member) isEnumValue(value string) bool {
+	for _, v := range m.Enum {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Relation) Validate() error {
 	if r.To.SnakeName() == "" {
 		return xerrors.New("must be declared 'to' property in relation")
@@ -971,11 +1380,7 @@ func (r *Response) renderMember(parentMember *Member, includes []*Include) map[s
 					continue
 				}
 				key := member.RenderNameByProtocol("json")
-				if member.Example != nil {
-					rendered[key] = member.Example
-				} else {
-					rendered[key] = member.Type.DefaultValue()
-				}
+				rendered[key] = member.ExampleValue()
 			}
 		} else if len(include.Except) > 0 {
 			exceptMap := map[string]struct{}{}
@@ -993,11 +1398,7 @@ func (r *Response) renderMember(parentMember *Member, includes []*Include) map[s
 					continue
 				}
 				key := member.RenderNameByProtocol("json")
-				if member.Example != nil {
-					rendered[key] = member.Example
-				} else {
-					rendered[key] = member.Type.DefaultValue()
-				}
+				rendered[key] = member.ExampleValue()
 			}
 		}
 		if len(include.Include) > 0 {
@@ -1052,10 +1453,8 @@ func (r *Response) renderAll(class *Class) map[string]interface{} {
 		subClass := member.Type.Class()
 		if subClass != nil {
 			rendered[key] = r.renderAll(subClass)
-		} else if member.Example != nil {
-			rendered[key] = member.Example
 		} else {
-			rendered[key] = member.Type.DefaultValue()
+			rendered[key] = member.ExampleValue()
 		}
 	}
 	return rendered
@@ -1093,19 +1492,12 @@ func (r *Response) attributes(class *Class, prefix string) []*Attribute {
 		subClass := member.Type.Class()
 		if subClass != nil {
 			attrs = append(attrs, r.attributes(subClass, name)...)
-		} else if member.Example != nil {
-			attrs = append(attrs, &Attribute{
-				Name:    name,
-				Type:    member.Type.Name(),
-				Desc:    member.Description,
-				Example: member.Example,
-			})
 		} else {
 			attrs = append(attrs, &Attribute{
 				Name:    name,
 				Type:    member.Type.Name(),
-				Desc:    member.Description,
-				Example: member.Type.DefaultValue(),
+				Desc:    member.DescriptionWithEnum(),
+				Example: member.ExampleValue(),
 			})
 		}
 	}
@@ -69,13 +69,14 @@ func (w *Watcher) generateClassBySchemaPath(cfg *config.Config, path string) err
 	if err != nil {
 		return xerrors.Errorf("failed to create class writer from %s: %w", cfg.ClassPath)
 	}
-	reader := schema.NewReader()
-	schemata, err := reader.SchemaFromPath(path)
+	// schema files may be migrations that depend on each other, so read all files again
+	reader := schema.NewReaderWithConfig(cfg)
+	schemata, err := reader.SchemaFromPath(cfg.SchemaPath)
 	if err != nil {
-		return xerrors.Errorf("failed to read schema files from %s: %w", path, err)
+		return xerrors.Errorf("failed to read schema files from %s: %w", cfg.SchemaPath, err)
 	}
-	for _, schema := range schemata {
-		if err := writer.Write(cfg, schema.ToClass()); err != nil {
+	for _, class := range schema.ToClasses(schemata) {
+		if err := writer.Write(cfg, class); err != nil {
 			return xerrors.Errorf("failed to write by schema: %w", err)
 		}
 	}
@@ -188,6 +189,10 @@ func (w *Watcher) watchSchema(cfg *config.Config) error {
 	if err != nil {
 		return xerrors.Errorf("failed to create fsnotify instance: %w", err)
 	}
+	if cfg.ReadsSchemaFromDatabase() {
+		// changes of database are not notified
+		return nil
+	}
 	if err := watcher.Add(cfg.SchemaPath); err != nil {
 		return xerrors.Errorf("failed to add path %s: %w", cfg.SchemaPath, err)
 	}